@@ -0,0 +1,107 @@
+package gowords
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined kinds of elements of Android string resources in writing
+const (
+	androidString  string = "string"
+	androidArray   string = "string-array"
+	androidPlurals string = "plurals"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromAndroid create a new instance of WordsCollection by reading Android string resources
+// like "res/values-fa/strings.xml", names of each resource are joined by its suffix.
+// Items of `<string-array>` are named by index like "planets[0]", and items of `<plurals>` by quantity like "songs[one]".
+// Values are decoded by rules of Android, markup such as `<b>` is kept and `<xliff:g>` tags are removed.
+func NewWordsCollectionFromAndroid(resources ...Resource) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseAndroid) })
+}
+
+// NewWordsRepositoryFromAndroid create a new instance of WordsRepository by reading Android string resources,
+// same as "NewWordsCollectionFromAndroid"
+func NewWordsRepositoryFromAndroid(resources ...Resource) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseAndroid) })
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// androidElement an element of Android string resources in writing, items of array and plurals are grouped by name
+type androidElement struct {
+	kind  string
+	name  string
+	forms []string
+	items []string
+}
+
+// WriteAndroid write names and values of words as Android string resources in order of source.
+// Names of forms like "planets[0]" and "songs[one]" are grouped to `<string-array>` and `<plurals>`.
+// Balanced tags of markup such as `<b>bold</b>` are written as markup, as kept by loading.
+// Words must be Enumerable.
+func WriteAndroid(writer io.Writer, words Words) error {
+	var (
+		elements []*androidElement
+		groups   map[[2]string]*androidElement = make(map[[2]string]*androidElement)
+	)
+	err := enumerate(words, func(name string, value string) bool {
+		var kind, id, form = androidString, name, internal.Empty
+		if base, suffix, found := internal.SplitFormName(name); found {
+			var count int // Items of array must be in order of index
+			if array, found := groups[[2]string{androidArray, base}]; found {
+				count = len(array.items)
+			}
+			switch {
+			case internal.IsQuantity(suffix):
+				kind, id, form = androidPlurals, base, suffix
+			case suffix == strconv.Itoa(count):
+				kind, id, form = androidArray, base, suffix
+			}
+		}
+		if kind == androidString {
+			elements = append(elements, &androidElement{kind: kind, name: name, items: []string{value}})
+			return true
+		}
+		group, found := groups[[2]string{kind, id}]
+		if !found {
+			group = &androidElement{kind: kind, name: id}
+			groups[[2]string{kind, id}] = group
+			elements = append(elements, group)
+		}
+		group.forms = append(group.forms, form)
+		group.items = append(group.items, value)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	var buffer = bufio.NewWriter(writer)
+	buffer.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<resources>\n")
+	for _, element := range elements {
+		var start = "    <" + element.kind + " name=" + internal.QuoteXML(element.name) + ">"
+		if element.kind == androidString {
+			buffer.WriteString(start + internal.EscapeAndroid(element.items[0]) + "</" + element.kind + ">\n")
+			continue
+		}
+		buffer.WriteString(start + "\n")
+		for index, item := range element.items {
+			var attributes string
+			if element.kind == androidPlurals {
+				attributes = " quantity=" + internal.QuoteXML(element.forms[index])
+			}
+			buffer.WriteString("        <item" + attributes + ">" + internal.EscapeAndroid(item) + "</item>\n")
+		}
+		buffer.WriteString("    </" + element.kind + ">\n")
+	}
+	buffer.WriteString("</resources>\n")
+	return buffer.Flush()
+}
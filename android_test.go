@@ -0,0 +1,187 @@
+package gowords_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromAndroid(t *testing.T) {
+	const EN core.Suffix = "_EN"
+	const FA core.Suffix = "_FA"
+	want := map[string]string{
+		"app_name_EN":     "MyApp",
+		"welcome_EN":      "Welcome, <b>dear</b> user!",
+		"apostrophe_EN":   `Don't & "quote"`,
+		"padded_EN":       "  padded  ",
+		"count_EN":        "You have %d messages",
+		"escapes_EN":      "Line\nTab\tAt@",
+		"planets[0]_EN":   "Mercury",
+		"planets[1]_EN":   "Venus",
+		"songs[one]_EN":   "%d song",
+		"songs[other]_EN": "%d songs",
+		"app_name_FA":     "برنامه من",
+		"songs[other]_FA": "%d آهنگ",
+	}
+	resources := func() []Resource {
+		return []Resource{
+			{Reader: openWords(t, "android/values/strings.xml"), Suffix: EN},
+			{Reader: openWords(t, "android/values-fa/strings.xml"), Suffix: FA},
+		}
+	}
+	wCollection, err := NewWordsCollectionFromAndroid(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromAndroid(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}, want)
+
+	if value, found := wCollection.Find("max_EN"); found {
+		t.Errorf("WordsCollection.Find() = %q, %v, want %q, %v", value, found, "", false)
+	}
+
+	wordsFA, err := NewWithSuffix(wCollection, FA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := wordsFA.Get("app_name"); got != "برنامه من" {
+		t.Errorf("WithSuffix.Get() = %q, want %q", got, "برنامه من")
+	}
+}
+
+func TestNewWordsFromAndroid_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantErr  error
+		wantLine int
+	}{
+		{"root", "<strings>\n</strings>", core.ErrXMLInvalid, 1},
+		{"name not present", "<resources>\n<string>v</string>\n</resources>", core.ErrNameNotPresent, 2},
+		{"item of array", "<resources>\n<string-array name=\"k\">\n<string>v</string>\n</string-array>\n</resources>", core.ErrXMLInvalid, 3},
+		{"quantity", "<resources>\n<plurals name=\"k\">\n<item quantity=\"all\">v</item>\n</plurals>\n</resources>", core.ErrXMLInvalid, 3},
+		{"escape", "<resources>\n<string name=\"k\">v\\u12</string>\n</resources>", core.ErrEscapeInvalid, 2},
+		{"duplicated name", "<resources>\n<string name=\"k\">v1</string>\n<string name=\"k\">v2</string>\n</resources>", core.ErrNameDuplicated, 3},
+		{"not closed", "<resources>\n<string name=\"k\">v1\n</resources>", nil, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromAndroid(Resource{Reader: strings.NewReader(tt.source)})
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("NewWordsCollectionFromAndroid() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine {
+				t.Errorf("NewWordsCollectionFromAndroid() error = %+v, want line %v", parseError, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestNewWordsFromAndroid_Resources(t *testing.T) {
+	const source string = `<resources><string name="k">v</string></resources>`
+	tests := []struct {
+		name      string
+		resources []Resource
+		wantErr   error
+	}{
+		{"no resource", nil, core.ErrResourcesEmpty},
+		{"nil reader", []Resource{{Reader: nil}}, core.ErrReaderNil},
+		{"invalid suffix", []Resource{{Reader: strings.NewReader(source), Suffix: "  "}}, core.ErrSuffixIsInvalid},
+		{"same suffix", []Resource{{Reader: strings.NewReader(source), Suffix: "_EN"}, {Reader: strings.NewReader(source), Suffix: "_EN"}}, core.ErrNameDuplicated},
+		{"different suffix", []Resource{{Reader: strings.NewReader(source), Suffix: "_EN"}, {Reader: strings.NewReader(source), Suffix: "_FA"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWordsCollectionFromAndroid(tt.resources...); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWordsCollectionFromAndroid() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteAndroid(t *testing.T) {
+	const source string = "title = MyApp\n" +
+		"quote = Don't \\\"quote\\\" <b>\n" +
+		"padded = \"  padded  \"\n" +
+		"planets[0] = Mercury\n" +
+		"songs[one] = %d song\n" +
+		"planets[1] = Venus\n" +
+		"songs[other] = %d songs\n" +
+		"unordered[1] = One"
+	const want string = `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="title">MyApp</string>
+    <string name="quote">Don\'t \"quote\" &lt;b&gt;</string>
+    <string name="padded">"  padded  "</string>
+    <string-array name="planets">
+        <item>Mercury</item>
+        <item>Venus</item>
+    </string-array>
+    <plurals name="songs">
+        <item quantity="one">%d song</item>
+        <item quantity="other">%d songs</item>
+    </plurals>
+    <string name="unordered[1]">One</string>
+</resources>
+`
+	for kind, words := range storagesOf(t, source, WithEscapes()) {
+		var buffer bytes.Buffer
+		if err := WriteAndroid(&buffer, words); err != nil {
+			t.Fatalf("%s WriteAndroid() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WriteAndroid() = %v, want %v", kind, buffer.String(), want)
+		}
+		w, err := NewWordsCollectionFromAndroid(Resource{Reader: &buffer})
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromAndroid() error = %v", kind, err)
+		}
+		findAll(t, map[string]Words{kind: w}, map[string]string{
+			"quote":        `Don't "quote" <b>`,
+			"padded":       "  padded  ",
+			"planets[1]":   "Venus",
+			"songs[other]": "%d songs",
+			"unordered[1]": "One",
+		})
+	}
+
+	if err := WriteAndroid(&bytes.Buffer{}, WithSuffix{}); !errors.Is(err, core.ErrWordsNotEnumerable) {
+		t.Errorf("WriteAndroid() error = %v, want %v", err, core.ErrWordsNotEnumerable)
+	}
+}
+
+func TestWriteAndroid_Markup(t *testing.T) {
+	const source string = `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="styled">Hello <b>bold <i>world</i></b> &lt;tag&gt; a &lt; b</string>
+    <string name="link"><a href="https://example.com/?a=1&amp;b=2">Don\'t</a><br></br></string>
+</resources>
+`
+	w, err := NewWordsRepositoryFromAndroid(Resource{Reader: strings.NewReader(source)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsRepository": w}, map[string]string{
+		"styled": "Hello <b>bold <i>world</i></b> <tag> a < b",
+		"link":   `<a href="https://example.com/?a=1&amp;b=2">Don't</a><br></br>`,
+	})
+	var buffer bytes.Buffer
+	if err := WriteAndroid(&buffer, w); err != nil {
+		t.Fatal(err)
+	}
+	if buffer.String() != source {
+		t.Errorf("WriteAndroid() = %v, want %v", buffer.String(), source)
+	}
+}
@@ -0,0 +1,42 @@
+package gowords
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromApple create a new instance of WordsCollection by reading Apple resources
+// like "fa.lproj/Localizable.strings" and "fa.lproj/Localizable.stringsdict", names of each resource are joined by its suffix.
+// Strings files like `"title" = "MyApp";` may be UTF-8 or UTF-16 with byte order mark.
+// Property lists of stringsdict are detected by content, the format of each entry is named by its key,
+// and plural forms by quantity like "%d files[one]", or by variable and quantity like "%d files[count.one]" for several variables.
+func NewWordsCollectionFromApple(resources ...Resource) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseApple) })
+}
+
+// NewWordsRepositoryFromApple create a new instance of WordsRepository by reading Apple resources,
+// same as "NewWordsCollectionFromApple"
+func NewWordsRepositoryFromApple(resources ...Resource) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseApple) })
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WriteAppleStrings write names and values of words as an Apple strings file in UTF-8 in order of source.
+// Words must be Enumerable.
+func WriteAppleStrings(writer io.Writer, words Words) error {
+	var buffer = bufio.NewWriter(writer)
+	err := enumerate(words, func(name string, value string) bool {
+		buffer.WriteString(internal.EscapeStrings(name) + " = " + internal.EscapeStrings(value) + ";\n")
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	return buffer.Flush()
+}
@@ -0,0 +1,99 @@
+package gowords_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromApple(t *testing.T) {
+	const EN core.Suffix = "_EN"
+	const FA core.Suffix = "_FA"
+	want := map[string]string{
+		"app_name_EN":                              "MyApp",
+		"quote_EN":                                 "Say \"Hi\"\nNow",
+		"unquoted_key_EN":                          "Unicode AB",
+		"key with spaces_EN":                       "value",
+		"%d files_EN":                              "%#@files@",
+		"%d files[one]_EN":                         "%d file",
+		"%d files[other]_EN":                       "%d files",
+		"%d files in %d folders_EN":                "%#@files@ in %#@folders@",
+		"%d files in %d folders[files.one]_EN":     "%d file",
+		"%d files in %d folders[folders.other]_EN": "%d folders",
+		"app_name_FA":                              "برنامه من",
+	}
+	resources := func() []Resource {
+		return []Resource{
+			{Reader: openWords(t, "apple/en.lproj/Localizable.strings"), Suffix: EN},
+			{Reader: openWords(t, "apple/en.lproj/Localizable.stringsdict"), Suffix: EN},
+			{Reader: openWords(t, "apple/fa.lproj/Localizable.strings"), Suffix: FA},
+		}
+	}
+	wCollection, err := NewWordsCollectionFromApple(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromApple(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}, want)
+}
+
+func TestNewWordsFromApple_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantErr  error
+		wantLine int
+	}{
+		{"separator", "\"k1\" = \"v1\";\n\"k2\" : \"v2\";", core.ErrStringsInvalid, 2},
+		{"end", "\"k1\" = \"v1\"\n\"k2\" = \"v2\";", core.ErrStringsInvalid, 2},
+		{"quote", "\"k1\" = \"v1;", core.ErrQuoteInvalid, 1},
+		{"comment", "\"k1\" = \"v1\";\n/* comment", core.ErrBlockNotClosed, 2},
+		{"escape", "\"k1\" = \"\\u12\";", core.ErrEscapeInvalid, 1},
+		{"duplicated name", "\"k1\" = \"v1\";\n\"k1\" = \"v2\";", core.ErrNameDuplicated, 2},
+		{"plist root", "<dict>\n</dict>", core.ErrXMLInvalid, 1},
+		{"plist key", "<plist>\n<dict>\n<string>v</string>\n</dict>\n</plist>", core.ErrXMLInvalid, 3},
+		{"plist entry", "<plist>\n<dict>\n<key>k</key>\n<string>v</string>\n</dict>\n</plist>", core.ErrXMLInvalid, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromApple(Resource{Reader: strings.NewReader(tt.source)})
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewWordsCollectionFromApple() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine {
+				t.Errorf("NewWordsCollectionFromApple() error = %+v, want line %v", parseError, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestWriteAppleStrings(t *testing.T) {
+	const source string = "title = MyApp\nquote = Say \\\"Hi\\\"\\nNow\npath = c:\\\\temp"
+	const want string = "\"title\" = \"MyApp\";\n\"quote\" = \"Say \\\"Hi\\\"\\nNow\";\n\"path\" = \"c:\\\\temp\";\n"
+	for kind, words := range storagesOf(t, source, WithEscapes()) {
+		var buffer bytes.Buffer
+		if err := WriteAppleStrings(&buffer, words); err != nil {
+			t.Fatalf("%s WriteAppleStrings() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WriteAppleStrings() = %v, want %v", kind, buffer.String(), want)
+		}
+		w, err := NewWordsCollectionFromApple(Resource{Reader: &buffer})
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromApple() error = %v", kind, err)
+		}
+		findAll(t, map[string]Words{kind: w}, map[string]string{"title": "MyApp", "quote": "Say \"Hi\"\nNow", "path": `c:\temp`})
+	}
+}
@@ -1,6 +1,12 @@
 package gowords
 
 import (
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
 	"github.com/saleh-rahimzadeh/go-words/internal"
 )
 
@@ -9,6 +15,9 @@ import (
 // WordsCollection provide words table and text resource with accepting string source and storing in map
 type WordsCollection struct {
 	collection map[string]string
+	names      []string            // Names in order of source
+	sorted     []string            // Names in sorted order, the index of searching by prefix
+	normalize  func(string) string // Function to normalize searched names, nil keeps names as is
 }
 
 //──────────────────────────────────────────────────────────────────────────────────────────────────
@@ -25,43 +34,145 @@ func (w WordsCollection) Find(name string) (string, bool) {
 	if !ok {
 		return internal.Empty, false
 	}
+	if w.normalize != nil {
+		name = w.normalize(name)
+	}
 	if value, found := w.collection[name]; found {
 		return value, true
 	}
 	return internal.Empty, false
 }
 
+// WriteTo write canonical source of words to writer in order of source, by default separator and comment with escapes,
+// which is parsed to same words by "WithEscapes" option. Implement "io.WriterTo".
+func (w WordsCollection) WriteTo(writer io.Writer) (int64, error) {
+	return writeTo(writer, w)
+}
+
+// FindPrefix return all names which start with prefix and their values, the prefix is normalized like names
+func (w WordsCollection) FindPrefix(prefix string) map[string]string {
+	return collectPrefix(func(yield func(string, string) bool) { w.ScanPrefix(prefix, yield) })
+}
+
+// ScanPrefix call yield for each name which start with prefix and its value in sorted order of names,
+// stop when yield return `false`. The prefix is normalized like names, and names are found by binary search.
+func (w WordsCollection) ScanPrefix(prefix string, yield func(name string, value string) bool) {
+	if w.normalize != nil {
+		prefix = w.normalize(prefix)
+	}
+	for index := sort.SearchStrings(w.sorted, prefix); index < len(w.sorted) && strings.HasPrefix(w.sorted[index], prefix); index++ {
+		if !yield(w.sorted[index], w.collection[w.sorted[index]]) {
+			return
+		}
+	}
+}
+
+// Len return number of names
+func (w WordsCollection) Len() int {
+	return len(w.names)
+}
+
+// Keys return all names in order of source
+func (w WordsCollection) Keys() []string {
+	return append([]string{}, w.names...)
+}
+
+// Range call yield for each name and value in order of source, stop when yield return `false`
+func (w WordsCollection) Range(yield func(name string, value string) bool) {
+	_ = w.walk(yield)
+}
+
+// normalization return the function to normalize names, nil if names are kept as is
+func (w WordsCollection) normalization() func(string) string {
+	return w.normalize
+}
+
+// walk call yield for each name and value in order of source, stop when yield return `false`
+func (w WordsCollection) walk(yield func(name string, value string) bool) error {
+	for _, name := range w.names {
+		if !yield(name, w.collection[name]) {
+			break
+		}
+	}
+	return nil
+}
+
 //──────────────────────────────────────────────────────────────────────────────────────────────────
 
 // NewWordsCollection create a new instance of WordsCollection
-func NewWordsCollection(source string, separator rune, comment rune) (WordsCollection, error) {
-	var (
-		separatorCharacter string = string(separator)
-		commentCharacter   string = string(comment)
-		err                error
-	)
+func NewWordsCollection(source string, separator rune, comment rune, options ...Option) (WordsCollection, error) {
+	return newWordsCollection(source, internal.Empty, separator, comment, options)
+}
+
+// newWordsCollection create a new instance of WordsCollection, the name of source is used in errors
+func newWordsCollection(source string, name string, separator rune, comment rune, options []Option) (WordsCollection, error) {
+	source = internal.DecodeUnicodeText(source)
 
-	err = internal.ValidationSource(source)
+	err := internal.ValidationSource(source)
 	if err != nil {
 		return WordsCollection{}, err
 	}
 
-	err = internal.ValidationDelimiters(separatorCharacter, commentCharacter)
+	config, err := configure(separator, comment, options)
 	if err != nil {
 		return WordsCollection{}, err
 	}
 
-	repository, err := internal.Normalization(source, separatorCharacter, commentCharacter)
+	records, err := internal.Collect(internal.NewScanner(strings.NewReader(source), name, config.grammar))
 	if err != nil {
 		return WordsCollection{}, err
 	}
 
-	collection, err := internal.Treasure(repository, separatorCharacter)
-	if err != nil {
-		return WordsCollection{}, err
+	var collection = collectionOf(records)
+	collection.normalize = config.grammar.Normalize
+	return collection, nil
+}
+
+// collectionOf create a new instance of WordsCollection from unique records
+func collectionOf(records []internal.Record) WordsCollection {
+	var (
+		collection map[string]string = make(map[string]string, len(records))
+		names      []string          = make([]string, len(records))
+	)
+	for index, record := range records {
+		collection[record.Key] = record.Value
+		names[index] = record.Key
 	}
 
+	var sorted = append([]string{}, names...)
+	sort.Strings(sorted)
+
 	return WordsCollection{
 		collection: collection,
-	}, nil
+		names:      names,
+		sorted:     sorted,
+	}
+}
+
+// NewWordsCollectionFromReader create a new instance of WordsCollection by reading whole source from reader
+func NewWordsCollectionFromReader(reader io.Reader, separator rune, comment rune, options ...Option) (WordsCollection, error) {
+	if reader == nil {
+		return WordsCollection{}, core.ErrReaderNil
+	}
+
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return WordsCollection{}, err
+	}
+
+	return newWordsCollection(string(source), internal.SourceName(reader), separator, comment, options)
+}
+
+// NewWordsCollectionFS create a new instance of WordsCollection by reading whole source from named file of file system
+func NewWordsCollectionFS(fsys fs.FS, name string, separator rune, comment rune, options ...Option) (WordsCollection, error) {
+	if fsys == nil {
+		return WordsCollection{}, core.ErrFileSystemNil
+	}
+
+	source, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return WordsCollection{}, err
+	}
+
+	return newWordsCollection(string(source), name, separator, comment, options)
 }
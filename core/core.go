@@ -2,6 +2,8 @@ package core
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 //──────────────────────────────────────────────────────────────────────────────────────────────────
@@ -12,6 +14,9 @@ const (
 	Comment   rune = '#'
 )
 
+// ContextSeparator the separator of context and message of gettext catalogs in names, same as gettext
+const ContextSeparator string = "\x04"
+
 //┌ Errors
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -30,7 +35,30 @@ var (
 	ErrLineComment             error = errors.New("line is comment")
 	ErrFileNil                 error = errors.New("file is nil")
 	ErrFileEmpty               error = errors.New("file is empty")
+	ErrFileUnsupported         error = errors.New("file does not support random access")
+	ErrReaderNil               error = errors.New("reader is nil")
+	ErrWriterNil               error = errors.New("writer is nil")
+	ErrFileSystemNil           error = errors.New("file system is nil")
 	ErrSuffixIsInvalid         error = errors.New("suffix is invalid")
+	ErrPrefixIsInvalid         error = errors.New("prefix is invalid")
+	ErrBlockNotClosed          error = errors.New("multi-line block is not closed")
+	ErrEscapeInvalid           error = errors.New("escape sequence is invalid")
+	ErrQuoteInvalid            error = errors.New("quoted value is invalid, it must be enclosed in double quotes")
+	ErrIncludeInvalid          error = errors.New("path of include directive is invalid")
+	ErrIncludeCycle            error = errors.New("include directive is cyclic")
+	ErrJSONInvalid             error = errors.New("JSON source is invalid, it must be an object of names to strings, numbers, booleans, null or objects")
+	ErrJSONConflict            error = errors.New("name is both a value and an object of other names in JSON")
+	ErrWordsNotEnumerable      error = errors.New("words does not support enumeration")
+	ErrPOInvalid               error = errors.New("PO catalog is invalid, unknown or unexpected keyword")
+	ErrMOInvalid               error = errors.New("MO catalog is invalid")
+	ErrXMLInvalid              error = errors.New("XML resource is invalid, unknown or unexpected element")
+	ErrStringsInvalid          error = errors.New("Apple strings is invalid, expected a string, '=' or ';'")
+	ErrResourcesEmpty          error = errors.New("no resource is provided")
+	ErrTableInvalid            error = errors.New("table is invalid, the header must be a name column and unique non-empty language columns")
+	ErrEncodeInvalid           error = errors.New("name or value cannot be encoded to be parsed same by options, use WithEscapes option")
+	ErrDelimiterInvalid        error = errors.New("delimiter is invalid, separator and comment prefixes must be punctuation or symbol characters except backslash and double quote")
+	ErrStorageInvalid          error = errors.New("storage kind is invalid")
+	ErrIndexStale              error = errors.New("index of file is stale, the file is changed after building the index")
 )
 
 //┌ Types
@@ -38,3 +66,144 @@ var (
 
 // Suffix suffix type for WithSuffix struct
 type Suffix string
+
+// Prefix prefix type for WithPrefix struct
+type Prefix string
+
+// Storage kind of storage created by "NewWords"
+type Storage int
+
+// Kinds of storage
+const (
+	StorageCollection Storage = iota // WordsCollection, the default
+	StorageRepository                // WordsRepository
+	StorageSorted                    // WordsSorted
+)
+
+// Trim policy of trimming whitespace around values, names are always trimmed
+type Trim int
+
+// Policies of trimming whitespace
+const (
+	TrimBoth    Trim = iota // Remove leading and trailing whitespace of values, the default
+	TrimLeading             // Remove only leading whitespace of values, trailing whitespace is kept
+	TrimNone                // Keep values as is after the separator until the end of line
+)
+
+// Fold rules of folding names to match names regardless of case or whitespace, rules are combined by "|"
+type Fold int
+
+// Rules of folding names
+const (
+	FoldCase  Fold = 1 << iota // Unicode case folding, such as "App" and "APP" to "app"
+	FoldSpace                  // Collapse whitespace inside names to one space, such as "App  Name" to "App Name"
+)
+
+// Duplicates policy of duplicated names in a source
+type Duplicates int
+
+// Policies of duplicated names
+const (
+	DuplicatesError Duplicates = iota // Report duplicated names as errors, the default
+	DuplicatesFirst                   // Keep the first value of duplicated names
+	DuplicatesLast                    // Keep the last value of duplicated names
+)
+
+//┌ Parse Error
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseError describe an invalid line of source, usable with "errors.As".
+// The reason is one of pre-defined errors such as ErrSeparatorNotPresent, ErrNameNotPresent and ErrNameDuplicated,
+// which can be checked with "errors.Is".
+type ParseError struct {
+	Source   string // Name of source such as file path, empty for string sources
+	Line     int    // Number of line, starting from 1
+	Column   int    // Number of column (in runes) of the problem in line, starting from 1
+	Text     string // Raw text of line
+	Name     string // Name of record for duplicated names
+	Previous int    // Number of line of first occurrence for duplicated names
+	First    string // Name of source of first occurrence for duplicated names
+	Err      error  // Reason of error
+}
+
+// Error return description of error with location
+func (e *ParseError) Error() string {
+	var builder strings.Builder
+	if e.Source != "" {
+		builder.WriteString(e.Source)
+		builder.WriteString(": ")
+	}
+	fmt.Fprintf(&builder, "line %d, column %d: %v", e.Line, e.Column, e.Err)
+	if e.Previous > 0 {
+		fmt.Fprintf(&builder, ", name '%s' is first defined at ", e.Name)
+		if e.First != "" && e.First != e.Source {
+			builder.WriteString(e.First)
+			builder.WriteString(": ")
+		}
+		fmt.Fprintf(&builder, "line %d", e.Previous)
+	} else {
+		fmt.Fprintf(&builder, ", at line '%s'", e.Text)
+	}
+	return builder.String()
+}
+
+// Unwrap return reason of error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors a list of all invalid lines of source, usable with "errors.As".
+// It also supports "errors.Is" and "errors.As" for each error since Go 1.20.
+type ParseErrors []*ParseError
+
+// Error return descriptions of all errors, each in a line
+func (e ParseErrors) Error() string {
+	var messages = make([]string, len(e))
+	for index, err := range e {
+		messages[index] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap return all errors
+func (e ParseErrors) Unwrap() []error {
+	var errs = make([]error, len(e))
+	for index, err := range e {
+		errs[index] = err
+	}
+	return errs
+}
+
+//┌ Override
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Override describe an occurrence of a duplicated name which is resolved by policy of duplicated names instead of error
+type Override struct {
+	Name     string // Name of record
+	Source   string // Name of source of the occurrence such as file path, empty for string sources
+	Line     int    // Number of line of the occurrence, starting from 1
+	Previous int    // Number of line of the previously kept occurrence
+	First    string // Name of source of the previously kept occurrence
+	Kept     bool   // `true` if value of the occurrence replaces the previous value, `false` if it is ignored
+}
+
+// String return description of override with location
+func (o Override) String() string {
+	var builder strings.Builder
+	if o.Source != "" {
+		builder.WriteString(o.Source)
+		builder.WriteString(": ")
+	}
+	fmt.Fprintf(&builder, "line %d: name '%s' ", o.Line, o.Name)
+	if o.Kept {
+		builder.WriteString("overrides ")
+	} else {
+		builder.WriteString("is ignored, kept at ")
+	}
+	if o.First != "" && o.First != o.Source {
+		builder.WriteString(o.First)
+		builder.WriteString(": ")
+	}
+	fmt.Fprintf(&builder, "line %d", o.Previous)
+	return builder.String()
+}
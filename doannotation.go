@@ -74,6 +74,37 @@ func (w DoAnnotation) FindFormatted(name string, arguments ...any) (string, bool
 	return fmt.Sprintf(value, arguments...), true
 }
 
+// FindErr search for a name then return value and `true` if found, else return empty string and `false`.
+// Value is not formatted.
+// Also return the error occurred in this call if underlying Words implements FallibleWords.
+func (w DoAnnotation) FindErr(name string) (string, bool, error) {
+	return FindErr(w.Words, name)
+}
+
+// Len return number of names of underlying Words, return 0 if it is not Enumerable
+func (w DoAnnotation) Len() int {
+	if source, ok := w.Words.(Enumerable); ok {
+		return source.Len()
+	}
+	return 0
+}
+
+// Keys return names of underlying Words in order of source, return nil if it is not Enumerable
+func (w DoAnnotation) Keys() []string {
+	if source, ok := w.Words.(Enumerable); ok {
+		return source.Keys()
+	}
+	return nil
+}
+
+// Range call yield for each name and value of underlying Words in order of source, values are not formatted.
+// Stop when yield return `false`, and do nothing if underlying Words is not Enumerable.
+func (w DoAnnotation) Range(yield func(name string, value string) bool) {
+	if source, ok := w.Words.(Enumerable); ok {
+		source.Range(yield)
+	}
+}
+
 //┌ Private Methods
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -90,6 +121,16 @@ func (w DoAnnotation) replacer(input string, arguments map[string]any) string {
 	})
 }
 
+// normalization return the function to normalize names of underlying Words, nil if names are kept as is
+func (w DoAnnotation) normalization() func(string) string {
+	return normalizationOf(w.Words)
+}
+
+// walk call yield for each name and value of underlying Words in order of source, values are not formatted
+func (w DoAnnotation) walk(yield func(name string, value string) bool) error {
+	return enumerate(w.Words, yield)
+}
+
 // convertIndexesToMap converts indexed annotations to map
 func (w DoAnnotation) convertIndexesToMap(arguments []any) map[string]any {
 	argumentMap := map[string]any{}
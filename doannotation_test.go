@@ -3,6 +3,7 @@ package gowords_test
 import (
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	. "github.com/saleh-rahimzadeh/go-words"
@@ -357,3 +358,36 @@ func BenchmarkDoAnnotationFormatted(b *testing.B) {
 		w.FindFormatted("k1", 1, 2.22, "three")
 	}
 }
+
+func TestDoAnnotation_Range(t *testing.T) {
+	w, err := NewWordsRepository("hello = Hello {{name}}\ncount = %d items", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wAnnotation, err := NewDoAnnotation(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := wAnnotation.Len(); got != 2 {
+		t.Errorf("DoAnnotation.Len() = %v, want %v", got, 2)
+	}
+	if got, want := wAnnotation.Keys(), []string{"hello", "count"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DoAnnotation.Keys() = %q, want %q", got, want)
+	}
+	var values []string
+	wAnnotation.Range(func(_ string, value string) bool {
+		values = append(values, value)
+		return true
+	})
+	if want := []string{"Hello {{name}}", "%d items"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("DoAnnotation.Range() = %q, want %q", values, want)
+	}
+
+	wNotEnumerable, err := NewDoAnnotation(wordsMap{"k1": "v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, keys := wNotEnumerable.Len(), wNotEnumerable.Keys(); got != 0 || keys != nil {
+		t.Errorf("DoAnnotation.Len(), Keys() = %v, %q, want %v, nil", got, keys, 0)
+	}
+}
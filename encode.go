@@ -0,0 +1,219 @@
+package gowords
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// EncodeLine create a line of source for name and value with escape sequences,
+// which is parsed to same name and value by "WithEscapes" option, also along with other options such as "WithMultiline",
+// "WithInlineComments" and "WithSections".
+// A value with leading or trailing whitespace is enclosed in double quotes.
+// The name is trimmed like searching names, return error if name is empty or has line break.
+func EncodeLine(name string, value string, separator rune, comment rune) (string, error) {
+	var (
+		separatorCharacter string = string(separator)
+		commentCharacter   string = string(comment)
+	)
+
+	err := internal.ValidationDelimiters(separatorCharacter, commentCharacter)
+	if err != nil {
+		return internal.Empty, err
+	}
+
+	name, ok := internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, core.ErrNameNotPresent
+	}
+
+	var comments = []string{commentCharacter}
+	return encodeName(name, separatorCharacter, comments) + separatorCharacter + encodeValue(value, comments), nil
+}
+
+// encodeName escape name, also escape leading comment prefix to not be a comment line,
+// and leading bracket and at sign to not be a section header or include directive
+func encodeName(name string, separator string, comments []string) string {
+	name = internal.EscapeText(name, separator)
+	var escaped = strings.HasPrefix(name, internal.SectionOpen) || strings.HasPrefix(name, internal.IncludeDirective)
+	for _, comment := range comments {
+		escaped = escaped || strings.HasPrefix(name, comment)
+	}
+	if escaped {
+		name = string(internal.EscapeByte) + name
+	}
+	return name
+}
+
+// encodeValue escape value, enclose value in double quotes if it has leading or trailing whitespace,
+// also escape comment prefixes to not be an inline comment, and leading double quote and heredoc prefix to be a plain value
+func encodeValue(value string, comments []string) string {
+	if value != strings.TrimSpace(value) {
+		return string(internal.QuoteByte) + internal.EscapeText(value, string(internal.QuoteByte)) + string(internal.QuoteByte)
+	}
+	value = internal.EscapeText(value, strings.Join(comments, internal.Empty))
+	if strings.HasPrefix(value, string(internal.QuoteByte)) || strings.HasPrefix(value, internal.HeredocPrefix) {
+		value = string(internal.EscapeByte) + value
+	}
+	return value
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined delimiter of heredoc blocks in writing
+const heredocEnd string = "END"
+
+// Encoder write canonical source of words like "name = value", which is parsed to same words by same delimiters and options
+type Encoder struct {
+	writer io.Writer
+	config configuration
+	sorted bool
+}
+
+// NewEncoder create a new instance of Encoder to write source to writer, with delimiters and options of parsing the source.
+// With "WithEscapes" option names and values are escaped like "EncodeLine", else with "WithMultiline" option
+// values of multiple lines or with leading or trailing whitespace are written as heredoc blocks.
+func NewEncoder(writer io.Writer, separator rune, comment rune, options ...Option) (*Encoder, error) {
+	if writer == nil {
+		return nil, core.ErrWriterNil
+	}
+
+	config, err := configure(separator, comment, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encoder{
+		writer: writer,
+		config: config,
+	}, nil
+}
+
+// SetSorted specify order of names, sorted by name if `true`, else in order of source which is the default
+func (e *Encoder) SetSorted(sorted bool) {
+	e.sorted = sorted
+}
+
+// Encode write all names and values of words, words must be Enumerable.
+// Return "core.ErrEncodeInvalid" if a name or value can not be written to be parsed same by options, such as a name
+// containing the separator without "WithEscapes" option.
+func (e *Encoder) Encode(words Words) error {
+	_, err := e.encode(words)
+	return err
+}
+
+// encode write all names and values of words, return number of written bytes
+func (e *Encoder) encode(words Words) (int64, error) {
+	var pairs [][2]string
+	err := enumerate(words, func(name string, value string) bool {
+		pairs = append(pairs, [2]string{name, value})
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if e.sorted {
+		sort.SliceStable(pairs, func(i int, j int) bool { return pairs[i][0] < pairs[j][0] })
+	}
+
+	var (
+		counter = &countWriter{writer: e.writer}
+		buffer  = bufio.NewWriter(counter)
+	)
+	for _, pair := range pairs {
+		text, err := e.line(pair[0], pair[1])
+		if err != nil {
+			return counter.count, err
+		}
+		buffer.WriteString(text + internal.NewLine)
+	}
+	err = buffer.Flush()
+	return counter.count, err
+}
+
+// line create text of name and value, which is one line or a heredoc block, and check it to be parsed same
+func (e *Encoder) line(name string, value string) (string, error) {
+	name, ok := internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, core.ErrNameNotPresent
+	}
+
+	var (
+		grammar internal.Grammar = e.config.grammar
+		infix   string           = " " + grammar.Separator + " "
+	)
+	if grammar.Trim == core.TrimNone {
+		// Whitespace after separator is part of value
+		infix = " " + grammar.Separator
+	}
+	var (
+		prefix string = name + infix
+		text   string = prefix + value
+	)
+	switch {
+	case grammar.Escapes:
+		text = encodeName(name, grammar.Separator, grammar.Comments) + infix + encodeValue(value, grammar.Comments)
+	case grammar.Multiline && (strings.Contains(value, internal.NewLine) || (value != strings.TrimSpace(value) && grammar.Trim != core.TrimNone) ||
+		strings.HasPrefix(value, internal.HeredocPrefix) || strings.HasSuffix(value, internal.Continuation)):
+		var delimiter = heredocDelimiter(value)
+		text = prefix + internal.HeredocPrefix + delimiter + internal.NewLine + value + internal.NewLine + delimiter
+	}
+
+	// Parse text by the grammar to check it, include directives are not read
+	if _, found := grammar.Include(text); found {
+		return internal.Empty, fmt.Errorf("%w, name '%s'", core.ErrEncodeInvalid, name)
+	}
+	grammar.Includes = nil
+	var scanner = internal.NewScanner(strings.NewReader(text), internal.Empty, grammar)
+	record, err := scanner.Next()
+	if err != nil || record.Key != name || record.Value != value {
+		return internal.Empty, fmt.Errorf("%w, name '%s'", core.ErrEncodeInvalid, name)
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		return internal.Empty, fmt.Errorf("%w, name '%s'", core.ErrEncodeInvalid, name)
+	}
+	return text, nil
+}
+
+// heredocDelimiter return a delimiter of heredoc block which is not a line of value, such as "END" or "END1"
+func heredocDelimiter(value string) string {
+	var lines = make(map[string]bool)
+	for _, line := range strings.Split(value, internal.NewLine) {
+		lines[strings.TrimSpace(line)] = true
+	}
+	var delimiter = heredocEnd
+	for index := 1; lines[delimiter]; index++ {
+		delimiter = heredocEnd + strconv.Itoa(index)
+	}
+	return delimiter
+}
+
+// countWriter a writer which counts written bytes
+type countWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+// Write write data to underlying writer and count written bytes
+func (w *countWriter) Write(data []byte) (int, error) {
+	n, err := w.writer.Write(data)
+	w.count += int64(n)
+	return n, err
+}
+
+// writeTo write canonical source of words by default delimiters and escapes, for "WriteTo" methods of storages
+func writeTo(writer io.Writer, words Words) (int64, error) {
+	encoder, err := NewEncoder(writer, core.Separator, core.Comment, WithEscapes())
+	if err != nil {
+		return 0, err
+	}
+	return encoder.encode(words)
+}
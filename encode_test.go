@@ -0,0 +1,229 @@
+package gowords_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestEncodeLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		value    string
+		wantName string
+		want     string
+	}{
+		{"plain", "k1", "v1", "k1", "k1=v1"},
+		{"trimmed name", "  k2  ", "v2", "k2", "k2=v2"},
+		{"padded value", "k3", "  v3  ", "k3", `k3="  v3  "`},
+		{"padded value with quote", "k4", ` "v4" `, "k4", `k4=" \"v4\" "`},
+		{"separator in name", "a=b", "v5", "a=b", `a\=b=v5`},
+		{"comment in name", "#k6", "v6", "#k6", `\#k6=v6`},
+		{"line break", "k7", "line 1\nline 2", "k7", `k7=line 1\nline 2`},
+		{"tab", "k8", "a\tb", "k8", `k8=a\tb`},
+		{"trailing backslash", "k9", `a\`, "k9", `k9=a\\`},
+		{"leading quote", "k10", `"v10`, "k10", `k10=\"v10`},
+		{"heredoc", "k11", "<<<END", "k11", `k11=\<<<END`},
+		{"empty value", "k12", "", "k12", "k12="},
+		{"unicode", "کلید", "متن", "کلید", "کلید=متن"},
+		{"section in name", "[k13]", "v13", "[k13]", `\[k13]=v13`},
+		{"control", "k14", "a\x00b", "k14", `k14=a\u0000b`},
+		{"comment in value", "k15", "30 # seconds", "k15", `k15=30 \# seconds`},
+		{"comment in quoted value", "k16", " # ", "k16", `k16=" # "`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeLine(tt.key, tt.value, core.Separator, core.Comment)
+			if err != nil {
+				t.Fatalf("EncodeLine() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EncodeLine() = %q, want %q", got, tt.want)
+			}
+			for _, options := range [][]Option{{WithEscapes()}, {WithEscapes(), WithMultiline(), WithInlineComments(), WithSections()}} {
+				w, err := NewWordsCollection(got, core.Separator, core.Comment, options...)
+				if err != nil {
+					t.Fatalf("NewWordsCollection() error = %v", err)
+				}
+				if value, found := w.Find(tt.wantName); !found || value != tt.value {
+					t.Errorf("Find(%q) = %q, %v, want %q, %v", tt.wantName, value, found, tt.value, true)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeLine_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		separator rune
+		comment   rune
+		wantErr   error
+	}{
+		{"empty name", "  ", core.Separator, core.Comment, core.ErrNameNotPresent},
+		{"line break in name", "k\n1", core.Separator, core.Comment, core.ErrNameNotPresent},
+		{"same delimiters", "k1", core.Separator, core.Separator, core.ErrSameSeparatorAndComment},
+		{"invalid separator", "k1", '$', core.Comment, core.ErrSeparatorIsInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := EncodeLine(tt.key, "v1", tt.separator, tt.comment); !errors.Is(err, tt.wantErr) {
+				t.Errorf("EncodeLine() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	want := map[string]string{
+		"plain":     "value",
+		"padded":    "  padded  ",
+		"lines":     "line 1\n  line 2\nEND",
+		"heredoc":   "<<<END",
+		"backslash": `a\`,
+		"comment":   "30 # seconds",
+		"[section]": "v",
+		"unicode":   "متن",
+	}
+	var source strings.Builder
+	for name, value := range want {
+		line, err := EncodeLine(name, value, core.Separator, core.Comment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		source.WriteString(line + "\n")
+	}
+
+	tests := []struct {
+		name      string
+		separator rune
+		comment   rune
+		options   []Option
+	}{
+		{"escapes", core.Separator, core.Comment, []Option{WithEscapes()}},
+		{"custom delimiters", ':', ';', []Option{WithEscapes()}},
+		{"all options", core.Separator, core.Comment, []Option{WithEscapes(), WithMultiline(), WithInlineComments(), WithSections()}},
+		{"multiline without escapes", core.Separator, core.Comment, []Option{WithMultiline()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for kind, words := range storagesOf(t, source.String(), WithEscapes()) {
+				var buffer bytes.Buffer
+				encoder, err := NewEncoder(&buffer, tt.separator, tt.comment, tt.options...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := encoder.Encode(words); err != nil {
+					t.Fatalf("%s Encoder.Encode() error = %v", kind, err)
+				}
+				w, err := NewWordsCollection(buffer.String(), tt.separator, tt.comment, tt.options...)
+				if err != nil {
+					t.Fatalf("%s NewWordsCollection() error = %v, of source %v", kind, err, buffer.String())
+				}
+				findAll(t, map[string]Words{kind: w}, want)
+			}
+		})
+	}
+}
+
+func TestEncoder_Sorted(t *testing.T) {
+	const source string = "k3 = v3\nk1 = v1\nk2 = <<<END\nline 1\nline 2\nEND"
+	tests := []struct {
+		name   string
+		sorted bool
+		want   string
+	}{
+		{"order of source", false, "k3 = v3\nk1 = v1\nk2 = <<<END\nline 1\nline 2\nEND\n"},
+		{"sorted", true, "k1 = v1\nk2 = <<<END\nline 1\nline 2\nEND\nk3 = v3\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for kind, words := range storagesOf(t, source, WithMultiline()) {
+				var buffer bytes.Buffer
+				encoder, err := NewEncoder(&buffer, core.Separator, core.Comment, WithMultiline())
+				if err != nil {
+					t.Fatal(err)
+				}
+				encoder.SetSorted(tt.sorted)
+				if err := encoder.Encode(words); err != nil {
+					t.Fatalf("%s Encoder.Encode() error = %v", kind, err)
+				}
+				if buffer.String() != tt.want {
+					t.Errorf("%s Encoder.Encode() = %q, want %q", kind, buffer.String(), tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestEncoder_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		options []Option
+	}{
+		{"separator in name", `a\=b = v`, nil},
+		{"comment in name", `\#k = v`, nil},
+		{"line break", `k = a\nb`, nil},
+		{"padded value", `k = "  v  "`, nil},
+		{"inline comment", `k = a \# b`, []Option{WithInlineComments()}},
+		{"section header", `\[k] = v]`, []Option{WithSections()}},
+		{"include directive", `\@include k = v`, []Option{WithIncludes(fstest.MapFS{})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, err := NewWordsCollection(tt.source, core.Separator, core.Comment, WithEscapes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoder, err := NewEncoder(&bytes.Buffer{}, core.Separator, core.Comment, tt.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := encoder.Encode(words); !errors.Is(err, core.ErrEncodeInvalid) {
+				t.Errorf("Encoder.Encode() error = %v, want %v", err, core.ErrEncodeInvalid)
+			}
+		})
+	}
+
+	if _, err := NewEncoder(nil, core.Separator, core.Comment); !errors.Is(err, core.ErrWriterNil) {
+		t.Errorf("NewEncoder() error = %v, want %v", err, core.ErrWriterNil)
+	}
+	if _, err := NewEncoder(&bytes.Buffer{}, core.Separator, core.Separator); !errors.Is(err, core.ErrSameSeparatorAndComment) {
+		t.Errorf("NewEncoder() error = %v, want %v", err, core.ErrSameSeparatorAndComment)
+	}
+	encoder, err := NewEncoder(&bytes.Buffer{}, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := encoder.Encode(WithSuffix{}); !errors.Is(err, core.ErrWordsNotEnumerable) {
+		t.Errorf("Encoder.Encode() error = %v, want %v", err, core.ErrWordsNotEnumerable)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	const source string = "k1 = v1\n# comment\nk2 = \"  v2  \""
+	const want string = "k1 = v1\nk2 = \"  v2  \"\n"
+	for kind, words := range storagesOf(t, source, WithEscapes()) {
+		var buffer bytes.Buffer
+		n, err := words.(io.WriterTo).WriteTo(&buffer)
+		if err != nil {
+			t.Fatalf("%s WriteTo() error = %v", kind, err)
+		}
+		if buffer.String() != want || n != int64(len(want)) {
+			t.Errorf("%s WriteTo() = %q, %v, want %q, %v", kind, buffer.String(), n, want, len(want))
+		}
+	}
+}
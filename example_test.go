@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
+	"testing/fstest"
 
 	gowords "github.com/saleh-rahimzadeh/go-words"
 	"github.com/saleh-rahimzadeh/go-words/core"
@@ -123,6 +125,417 @@ k3=v3
 	// v1
 }
 
+func ExampleWordsCollection_Range() {
+	const source string = `
+title = MyApp
+version = 1.0
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Len())
+	w.Range(func(name string, value string) bool {
+		fmt.Println(name, "=", value)
+		return true
+	})
+
+	//Output:
+	// 2
+	// title = MyApp
+	// version = 1.0
+}
+
+func ExampleWordsSorted() {
+	const source string = `
+title = MyApp
+errors.notfound = Not found
+errors.auth = Access denied
+`
+
+	w, err := gowords.NewWordsSorted(source, core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("errors.auth"))
+
+	w.ScanPrefix("", func(name string, value string) bool {
+		fmt.Println(name, "=", value)
+		return true
+	})
+
+	//Output:
+	// Access denied
+	// errors.auth = Access denied
+	// errors.notfound = Not found
+	// title = MyApp
+}
+
+func ExampleNewWordsCollectionFS() {
+	// A file system such as "embed.FS" or "os.DirFS"
+	fsys := fstest.MapFS{
+		"words.txt": &fstest.MapFile{Data: []byte("k1=v1\nk2=v2")},
+	}
+
+	w, err := gowords.NewWordsCollectionFS(fsys, "words.txt", core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	value := w.Get("k2")
+	fmt.Println(value)
+
+	//Output: v2
+}
+
+//┌ Validate Examples
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func ExampleValidate() {
+	const source = `
+k1=v1
+=v2
+k3
+k1=v4
+`
+
+	err := gowords.Validate(source, core.Separator, core.Comment)
+	fmt.Println(err)
+
+	//Output:
+	// line 3, column 1: name not present in record, at line '=v2'
+	// line 4, column 1: separator not present in record, at line 'k3'
+	// line 5, column 1: duplicated name found, name 'k1' is first defined at line 2
+}
+
+//┌ Option Examples
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func ExampleWithMultiline() {
+	const source = `
+description = An enterprise \
+              application
+letter = <<<END
+Dear user,
+
+  Welcome to MyApp.
+END
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment, gowords.WithMultiline())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("description"))
+	fmt.Println(w.Get("letter"))
+
+	//Output:
+	// An enterprise application
+	// Dear user,
+	//
+	//   Welcome to MyApp.
+}
+
+func ExampleWithEscapes() {
+	const source = `
+padded = "  value  "
+a\=b = value of "a=b"
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment, gowords.WithEscapes())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%q\n", w.Get("padded"))
+	fmt.Println(w.Get("a=b"))
+
+	//Output:
+	// "  value  "
+	// value of "a=b"
+}
+
+func ExampleWithInlineComments() {
+	const source = `
+timeout = 30  # seconds
+color = red#blue
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment, gowords.WithInlineComments())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("timeout"))
+	fmt.Println(w.Get("color"))
+
+	//Output:
+	// 30
+	// red#blue
+}
+
+func ExampleWithSections() {
+	const source = `
+title = MyApp
+[errors]
+notfound = Not found
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment, gowords.WithSections())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("title"))
+	fmt.Println(w.Get("errors.notfound"))
+
+	//Output:
+	// MyApp
+	// Not found
+}
+
+func ExampleWithIncludes() {
+	// A file system such as "embed.FS" or "os.DirFS"
+	fsys := fstest.MapFS{
+		"main.txt":           &fstest.MapFile{Data: []byte("title = MyApp\n@include modules/errors.txt")},
+		"modules/errors.txt": &fstest.MapFile{Data: []byte("notfound = Not found")},
+	}
+
+	w, err := gowords.NewWordsCollectionFS(fsys, "main.txt", core.Separator, core.Comment, gowords.WithIncludes(fsys))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("title"))
+	fmt.Println(w.Get("notfound"))
+
+	//Output:
+	// MyApp
+	// Not found
+}
+
+func ExampleNewWords() {
+	const source string = `
+// Defaults
+Title => MyApp
+# Overrides
+title => MyApp 2
+`
+
+	w, err := gowords.NewWords(source,
+		gowords.WithStorage(core.StorageRepository),
+		gowords.WithSeparator("=>"),
+		gowords.WithComments("#", "//"),
+		gowords.WithDuplicates(core.DuplicatesLast),
+		gowords.WithNormalizer(strings.ToLower),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("TITLE"))
+
+	//Output: MyApp 2
+}
+
+func ExampleEncodeLine() {
+	line, err := gowords.EncodeLine("a=b", "  padded\n", core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(line)
+
+	//Output: a\=b="  padded\n"
+}
+
+//┌ Format Examples
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func ExampleEncoder() {
+	const source = `
+title = MyApp
+# Errors
+error = Not found
+address = <<<END
+  Street 1
+  City
+END
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment, gowords.WithMultiline())
+	if err != nil {
+		panic(err)
+	}
+
+	encoder, err := gowords.NewEncoder(os.Stdout, ':', ';', gowords.WithMultiline())
+	if err != nil {
+		panic(err)
+	}
+	encoder.SetSorted(true)
+	if err := encoder.Encode(w); err != nil {
+		panic(err)
+	}
+
+	//Output:
+	// address : <<<END
+	//   Street 1
+	//   City
+	// END
+	// error : Not found
+	// title : MyApp
+}
+
+func ExampleNewWordsCollectionFromJSON() {
+	const source = `{"title": "MyApp", "errors": {"notfound": "Not found"}}`
+
+	w, err := gowords.NewWordsCollectionFromJSON(strings.NewReader(source))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("errors.notfound"))
+
+	//Output: Not found
+}
+
+func ExampleNewWordsCollectionFromProperties() {
+	const source = `
+! Java resource bundle
+app.title = MyApp
+greeting = Hello, \
+           World
+`
+
+	w, err := gowords.NewWordsCollectionFromProperties(strings.NewReader(source))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("app.title"))
+	fmt.Println(w.Get("greeting"))
+
+	//Output:
+	// MyApp
+	// Hello, World
+}
+
+func ExampleNewWordsCollectionFromPO() {
+	const source = `
+msgctxt "menu"
+msgid "Open"
+msgstr "Open file"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d file"
+msgstr[1] "%d files"
+`
+
+	w, err := gowords.NewWordsCollectionFromPO(strings.NewReader(source))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("menu" + core.ContextSeparator + "Open"))
+	fmt.Println(w.Get("%d file[1]"))
+
+	//Output:
+	// Open file
+	// %d files
+}
+
+func ExampleNewWordsCollectionFromAndroid() {
+	const valuesEN = `<resources>
+    <string name="title">MyApp</string>
+    <plurals name="songs">
+        <item quantity="one">%d song</item>
+        <item quantity="other">%d songs</item>
+    </plurals>
+</resources>`
+	const valuesFA = `<resources>
+    <string name="title">برنامه من</string>
+    <plurals name="songs">
+        <item quantity="other">%d آهنگ</item>
+    </plurals>
+</resources>`
+
+	w, err := gowords.NewWordsCollectionFromAndroid(
+		gowords.Resource{Reader: strings.NewReader(valuesEN), Suffix: "_EN"},
+		gowords.Resource{Reader: strings.NewReader(valuesFA), Suffix: "_FA"},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	wordsFA, err := gowords.NewWithSuffix(w, "_FA")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("songs[one]_EN"))
+	fmt.Println(wordsFA.Get("title"))
+	fmt.Println(wordsFA.Get("songs[other]"))
+
+	//Output:
+	// %d song
+	// برنامه من
+	// %d آهنگ
+}
+
+func ExampleNewWordsCollectionFromCSV() {
+	const table = `key,EN,FA
+title,MyApp,برنامه من
+greeting,"Hello, World",سلام
+`
+
+	w, err := gowords.NewWordsCollectionFromCSV(strings.NewReader(table), ',')
+	if err != nil {
+		panic(err)
+	}
+
+	wordsFA, err := gowords.NewWithSuffix(w, "_FA")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(w.Get("greeting_EN"))
+	fmt.Println(wordsFA.Get("title"))
+
+	//Output:
+	// Hello, World
+	// برنامه من
+}
+
+func ExampleWriteJSONNested() {
+	const source = `
+title = MyApp
+errors.notfound = Not found
+`
+
+	w, err := gowords.NewWordsRepository(source, core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	err = gowords.WriteJSONNested(os.Stdout, w)
+	if err != nil {
+		panic(err)
+	}
+
+	//Output:
+	// {
+	//   "title": "MyApp",
+	//   "errors": {
+	//     "notfound": "Not found"
+	//   }
+	// }
+}
+
 //┌ WordsFile Examples
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -214,6 +627,47 @@ k2_FA = v2 FA
 	// v2 FA
 }
 
+func ExampleWithPrefix() {
+	const source = `
+title = MyApp
+billing.title = Billing
+billing.invoice_EN = Invoice
+billing.invoice_FA = Farsi Invoice
+`
+
+	var wRepository gowords.Words
+	wRepository, err := gowords.NewWordsRepository(source, core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	const BILLING core.Prefix = "billing."
+
+	wordsBilling, err := gowords.NewWithPrefix(wRepository, BILLING)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(wordsBilling.Get("title"))
+
+	wordsBillingEN, err := gowords.NewWithSuffix(wordsBilling, "_EN")
+	if err != nil {
+		panic(err)
+	}
+
+	value, found := wordsBillingEN.Find("invoice")
+	if found {
+		fmt.Println(value)
+	}
+
+	fmt.Println(wordsBilling.(gowords.Enumerable).Keys())
+
+	//Output:
+	// Billing
+	// Invoice
+	// [title invoice_EN invoice_FA]
+}
+
 //┌ DoAnnotation
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -279,6 +733,29 @@ person_formatted=Hi, my name is %s, when I was %d years old I was a %v developer
 //┌ Services Example
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
+func ExampleWordsCollection_ScanPrefix() {
+	const source string = `
+title = MyApp
+errors.auth.expired = Session expired
+errors.auth.denied = Access denied
+errors.notfound = Not found
+`
+
+	w, err := gowords.NewWordsCollection(source, core.Separator, core.Comment)
+	if err != nil {
+		panic(err)
+	}
+
+	w.ScanPrefix("errors.auth.", func(name string, value string) bool {
+		fmt.Println(name, "=", value)
+		return true
+	})
+
+	//Output:
+	// errors.auth.denied = Access denied
+	// errors.auth.expired = Session expired
+}
+
 func ExampleGetBy() {
 	const source = `
 k1_EN = v1 EN
@@ -1,12 +1,15 @@
 package gowords
 
 import (
-	"bufio"
-	"errors"
-	"fmt"
+	"bytes"
 	"io"
+	"io/fs"
+	"math"
 	"os"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/saleh-rahimzadeh/go-words/core"
 	"github.com/saleh-rahimzadeh/go-words/internal"
@@ -16,11 +19,28 @@ import (
 
 // WordsFile provide words table and text resource with accepting file pointer and storing a pointer to the file
 type WordsFile struct {
-	file      *os.File
-	separator rune
-	comment   rune
-	fault     error
-	mutex     *sync.Mutex
+	file    io.ReaderAt // File as is, the decoded content is kept by state
+	closer  io.Closer
+	grammar internal.Grammar
+	name    string
+	indexed bool // Names are searched by the index of state
+	state   *fileState
+}
+
+// fileState the state of WordsFile which is shared between copies of an instance,
+// the mutex only guards the error, the index is loaded without locking.
+type fileState struct {
+	fault error
+	mutex sync.Mutex
+	index atomic.Value // *fileIndex
+}
+
+// fileIndex the decoded content of file of WordsFile and the index of its names in indexed mode,
+// it is replaced as a whole and never changed
+type fileIndex struct {
+	file      io.ReaderAt                  // Content of file decoded to UTF-8, which positions are offsets of
+	positions map[string]internal.Position // Names to position of their records
+	sorted    []string                     // Names in sorted order, the index of searching by prefix
 }
 
 //──────────────────────────────────────────────────────────────────────────────────────────────────
@@ -32,19 +52,35 @@ func (w WordsFile) Get(name string) string {
 }
 
 // Find search for a name then return value and `true` if found, else return empty string and `false`.
-// It is safe for concurrent use by multiple goroutines.
-func (w WordsFile) Find(name string) (value string, found bool) {
-	w.mutex.Lock()
-	defer w.mutex.Unlock()
-	return w.FindUnsafe(name)
+// It is safe for concurrent use by multiple goroutines, each call read the file independently without blocking other calls.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) Find(name string) (string, bool) {
+	value, found, err := w.FindErr(name)
+	if err != nil {
+		w.state.setFault(err)
+	}
+	return value, found
 }
 
 // FindUnsafe search for a name then return value and `true` if found, else return empty string and `false`.
-// It is unsafe for concurrent use by multiple goroutines.
-func (w *WordsFile) FindUnsafe(name string) (value string, found bool) {
+// It is same as "Find" and kept for compatibility.
+func (w *WordsFile) FindUnsafe(name string) (string, bool) {
+	if w.state == nil {
+		w.state = &fileState{}
+	}
+	return w.Find(name)
+}
+
+// FindErr search for a name then return value and `true` if found, else return empty string and `false`.
+// Also return the error occurred in reading or parsing the file in this call.
+// It is safe for concurrent use by multiple goroutines.
+func (w WordsFile) FindErr(name string) (value string, found bool, fault error) {
 	name, ok := internal.ValidationName(name)
 	if !ok {
-		return internal.Empty, false
+		return internal.Empty, false, nil
+	}
+	if w.grammar.Normalize != nil {
+		name = w.grammar.Normalize(name)
 	}
 
 	defer func() {
@@ -52,51 +88,109 @@ func (w *WordsFile) FindUnsafe(name string) (value string, found bool) {
 			value = internal.Empty
 			found = false
 			if err, ok := rec.(error); ok {
-				w.fault = err
+				fault = err
 			} else {
-				w.fault = core.ErrWords
+				fault = core.ErrWords
 			}
 		}
 	}()
 
-	_, err := w.file.Seek(0, io.SeekStart)
+	var index = w.state.getIndex()
+	if w.indexed {
+		position, found := index.positions[name]
+		if !found {
+			return internal.Empty, false, nil
+		}
+		value, err := w.record(index.file, position, name)
+		if err != nil {
+			return internal.Empty, false, err
+		}
+		return value, true, nil
+	}
+
+	err := w.scan(index.file, internal.Position{Line: 1}, func(record internal.Record) bool {
+		if record.Key == name {
+			value, found = record.Value, true
+			// Continue to the last occurrence to keep its value
+			return w.grammar.Duplicates == core.DuplicatesLast
+		}
+		return true
+	})
 	if err != nil {
-		w.fault = err
-		return internal.Empty, false
+		return internal.Empty, false, err
+	}
+
+	return value, found, nil
+}
+
+// CheckError check errors in file.
+// Also check for duplication of names by policy of duplicated names, and report overrides of "WithOverrides" option.
+// The file is decoded again, so changes of a file of UTF-16 are seen by following searches.
+// In indexed mode, also rebuild the index of names, the new index replaces the old one at once
+// so it is safe to call concurrently with searching.
+func (w *WordsFile) CheckError() (fault error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			if err, ok := rec.(error); ok {
+				fault = err
+			} else {
+				fault = core.ErrWords
+			}
+		}
+	}()
+
+	reader, err := decodeFile(w.file)
+	if err != nil {
+		return err
 	}
 
 	var (
-		separatorCharacter string = string(w.separator)
-		commentCharacter   string = string(w.comment)
-		line               string
+		names     map[string]internal.Position = make(map[string]internal.Position)
+		duplicate error
 	)
 
-	var scanner = bufio.NewScanner(w.file)
-	for scanner.Scan() {
-		line = scanner.Text()
-		key, value, err := internal.Parse(line, separatorCharacter, commentCharacter)
-		if err != nil {
-			if errors.Is(err, core.ErrLineEmpty) || errors.Is(err, core.ErrLineComment) {
-				continue
+	err = w.scan(reader, internal.Position{Line: 1}, func(record internal.Record) bool {
+		if previous, found := names[record.Key]; found {
+			switch w.grammar.Duplicates {
+			case core.DuplicatesFirst:
+				w.grammar.Override(record, previous)
+				return true
+			case core.DuplicatesLast:
+				w.grammar.Override(record, previous)
+				names[record.Key] = record.Position
+				return true
 			}
-			w.fault = err
-			return internal.Empty, false
-		}
-		if key == name {
-			return value, true
+			duplicate = internal.Duplication(record, previous)
+			return false
 		}
+		names[record.Key] = record.Position
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if duplicate != nil {
+		return duplicate
 	}
 
-	if scanner.Err() != nil {
-		w.fault = scanner.Err()
+	if !w.indexed {
+		w.state.setIndex(&fileIndex{file: reader})
+		return nil
 	}
 
-	return internal.Empty, false
+	var sorted = make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	w.state.setIndex(&fileIndex{file: reader, positions: names, sorted: sorted})
+	return nil
 }
 
-// CheckError check errors in file.
-// Also check for duplication of names.
-func (w *WordsFile) CheckError() (fault error) {
+// Validate check whole file and return all invalid lines and duplicated names as "core.ParseErrors",
+// unlike "CheckError" which stop at the first error.
+// Return nil if the file is valid.
+func (w WordsFile) Validate() (fault error) {
 	defer func() {
 		if rec := recover(); rec != nil {
 			if err, ok := rec.(error); ok {
@@ -107,69 +201,359 @@ func (w *WordsFile) CheckError() (fault error) {
 		}
 	}()
 
-	_, err := w.file.Seek(0, io.SeekStart)
+	scanner, err := w.scanner(w.state.getIndex().file, internal.Position{Line: 1})
 	if err != nil {
 		return err
 	}
 
+	faults, err := internal.Check(scanner)
+	if err != nil {
+		return err
+	}
+	if len(faults) > 0 {
+		return core.ParseErrors(faults)
+	}
+	return nil
+}
+
+// WriteTo write canonical source of words to writer in order of source, by default separator and comment with escapes,
+// which is parsed to same words by "WithEscapes" option. Implement "io.WriterTo".
+func (w WordsFile) WriteTo(writer io.Writer) (int64, error) {
+	return writeTo(writer, w)
+}
+
+// FindPrefix return all names which start with prefix and their values, the prefix is normalized like names.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) FindPrefix(prefix string) map[string]string {
+	return collectPrefix(func(yield func(string, string) bool) { w.ScanPrefix(prefix, yield) })
+}
+
+// ScanPrefix call yield for each name which start with prefix and its value in sorted order of names,
+// stop when yield return `false`. The prefix is normalized like names.
+// In indexed mode names are found by binary search and each value is read straight, else whole file is read.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) ScanPrefix(prefix string, yield func(name string, value string) bool) {
+	if err := w.scanPrefix(prefix, yield); err != nil {
+		w.state.setFault(err)
+	}
+}
+
+// scanPrefix call yield for each name which start with prefix and its value in sorted order of names
+func (w WordsFile) scanPrefix(prefix string, yield func(name string, value string) bool) error {
+	if w.grammar.Normalize != nil {
+		prefix = w.grammar.Normalize(prefix)
+	}
+
+	if w.indexed {
+		var index = w.state.getIndex()
+		for at := sort.SearchStrings(index.sorted, prefix); at < len(index.sorted) && strings.HasPrefix(index.sorted[at], prefix); at++ {
+			value, err := w.record(index.file, index.positions[index.sorted[at]], index.sorted[at])
+			if err != nil {
+				return err
+			}
+			if !yield(index.sorted[at], value) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	return scanSorted(w.walk, prefix, yield)
+}
+
+// Len return number of names by reading whole file.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) Len() int {
+	var count int
+	w.Range(func(string, string) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Keys return all names in order of file by reading whole file.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) Keys() []string {
+	keys, err := keysOf(w)
+	if err != nil {
+		w.state.setFault(err)
+		return nil
+	}
+	return keys
+}
+
+// Range call yield for each name and value in order of file, stop when yield return `false`.
+// The error occurred in reading the file is reported by "Err" method.
+func (w WordsFile) Range(yield func(name string, value string) bool) {
+	if err := w.walk(yield); err != nil {
+		w.state.setFault(err)
+	}
+}
+
+// normalization return the function to normalize names, nil if names are kept as is
+func (w WordsFile) normalization() func(string) string {
+	return w.grammar.Normalize
+}
+
+// walk call yield for each name and value in order of file, stop when yield return `false`.
+// Duplicated names which are kept by policy of duplicated names are yielded once.
+func (w WordsFile) walk(yield func(name string, value string) bool) error {
+	if w.grammar.Duplicates != core.DuplicatesError {
+		// Overrides are reported by "CheckError" and "Validate" only
+		w.grammar.Overrides = nil
+		scanner, err := w.scanner(w.state.getIndex().file, internal.Position{Line: 1})
+		if err != nil {
+			return err
+		}
+		records, err := internal.Collect(scanner)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if !yield(record.Key, record.Value) {
+				break
+			}
+		}
+		return nil
+	}
+	return w.scan(w.state.getIndex().file, internal.Position{Line: 1}, func(record internal.Record) bool {
+		return yield(record.Key, record.Value)
+	})
+}
+
+// record read the record of name at position of the index, return "core.ErrIndexStale" if the file is changed
+// and another record or no record is at the position
+func (w WordsFile) record(file io.ReaderAt, position internal.Position, name string) (string, error) {
 	var (
-		separatorCharacter string              = string(w.separator)
-		commentCharacter   string              = string(w.comment)
-		names              map[string]struct{} = make(map[string]struct{})
-		scanner            *bufio.Scanner      = bufio.NewScanner(w.file)
-		line               string
+		value string
+		found bool
 	)
+	err := w.scan(file, position, func(record internal.Record) bool {
+		value, found = record.Value, record.Key == name
+		return false
+	})
+	if err != nil {
+		return internal.Empty, err
+	}
+	if !found {
+		return internal.Empty, core.ErrIndexStale
+	}
+	return value, nil
+}
 
-	for scanner.Scan() {
-		line = scanner.Text()
-		key, _, err := internal.Parse(line, separatorCharacter, commentCharacter)
+// scan read records of decoded content of file from position and call yield for each record,
+// stop reading when yield return `false`.
+// Each call use its own section of the file, so it is safe for concurrent use by multiple goroutines.
+func (w WordsFile) scan(file io.ReaderAt, position internal.Position, yield func(record internal.Record) bool) error {
+	scanner, err := w.scanner(file, position)
+	if err != nil {
+		return err
+	}
+	for {
+		record, err := scanner.Next()
+		if err == io.EOF {
+			return nil
+		}
 		if err != nil {
-			if errors.Is(err, core.ErrLineEmpty) || errors.Is(err, core.ErrLineComment) {
-				continue
-			}
 			return err
 		}
-		if _, found := names[key]; found {
-			return fmt.Errorf("%w, name '%s'", core.ErrNameDuplicated, key)
+		if !yield(record) {
+			return nil
 		}
-		names[key] = struct{}{}
 	}
+}
 
-	if scanner.Err() != nil {
-		return scanner.Err()
+// scanner create a scanner to read records of decoded content of file from position, using its own section of the file.
+// A position in an included source is read from file system of include directives.
+func (w WordsFile) scanner(file io.ReaderAt, position internal.Position) (*internal.Scanner, error) {
+	if position.Source != internal.Empty && position.Source != w.name {
+		data, err := fs.ReadFile(w.grammar.Includes, position.Source)
+		if err != nil {
+			return nil, err
+		}
+		data = internal.DecodeUnicode(data)
+		if position.Offset > int64(len(data)) {
+			position.Offset = int64(len(data))
+		}
+		var scanner = internal.NewScanner(bytes.NewReader(data[position.Offset:]), position.Source, w.grammar)
+		scanner.Start(position)
+		return scanner, nil
 	}
 
-	return nil
+	var (
+		section io.Reader         = io.NewSectionReader(file, position.Offset, math.MaxInt64-position.Offset)
+		scanner *internal.Scanner = internal.NewScanner(section, w.name, w.grammar)
+	)
+	scanner.Start(position)
+	return scanner, nil
 }
 
-// Err get the error occurred in "Find" method
+// Err get the last error occurred in "Find" method
 func (w *WordsFile) Err() error {
-	return w.fault
+	return w.state.getFault()
+}
+
+// Close close the file if it is opened by "NewWordsFS", else do nothing.
+// The file passed to "NewWordsFile" must be closed by its owner.
+func (w WordsFile) Close() error {
+	if w.closer == nil {
+		return nil
+	}
+	return w.closer.Close()
 }
 
 //──────────────────────────────────────────────────────────────────────────────────────────────────
 
-// NewWordsFile create a new instance of WordsFile
-func NewWordsFile(file *os.File, separator rune, comment rune) (WordsFile, error) {
+// NewWordsFile create a new instance of WordsFile.
+// A byte order mark of UTF-8 is skipped, and a file of UTF-16 with byte order mark is decoded into memory,
+// again by each call of "CheckError" method.
+func NewWordsFile(file *os.File, separator rune, comment rune, options ...Option) (WordsFile, error) {
 	err := internal.ValidationFile(file)
 	if err != nil {
 		return WordsFile{}, err
 	}
 
-	var (
-		separatorCharacter string = string(separator)
-		commentCharacter   string = string(comment)
-	)
+	config, err := configure(separator, comment, options)
+	if err != nil {
+		return WordsFile{}, err
+	}
+
+	reader, err := decodeFile(file)
+	if err != nil {
+		return WordsFile{}, err
+	}
+
+	return WordsFile{
+		file:    file,
+		name:    file.Name(),
+		grammar: config.grammar,
+		state:   newFileState(reader),
+	}, nil
+}
+
+// NewWordsFileIndexed create a new instance of WordsFile in indexed mode.
+// It read whole file once to check errors and duplication of names, and build an index of names to offset of records,
+// then each search seek straight to the record instead of reading whole file.
+// Call "CheckError" method to rebuild the index if the file is changed,
+// a search which find another record at the offset of a name return "core.ErrIndexStale" until rebuilding.
+func NewWordsFileIndexed(file *os.File, separator rune, comment rune, options ...Option) (WordsFile, error) {
+	w, err := NewWordsFile(file, separator, comment, options...)
+	if err != nil {
+		return WordsFile{}, err
+	}
 
-	err = internal.ValidationDelimiters(separatorCharacter, commentCharacter)
+	w.indexed = true
+	err = w.CheckError()
 	if err != nil {
 		return WordsFile{}, err
 	}
 
+	return w, nil
+}
+
+// NewWordsFS create a new instance of WordsFile by opening named file of file system.
+// The file must support random access by "io.ReaderAt", call "Close" method to close the file.
+func NewWordsFS(fsys fs.FS, name string, separator rune, comment rune, options ...Option) (WordsFile, error) {
+	if fsys == nil {
+		return WordsFile{}, core.ErrFileSystemNil
+	}
+
+	config, err := configure(separator, comment, options)
+	if err != nil {
+		return WordsFile{}, err
+	}
+
+	file, err := fsys.Open(name)
+	if err != nil {
+		return WordsFile{}, err
+	}
+
+	source, err := internal.ValidationFSFile(file)
+	var reader io.ReaderAt
+	if err == nil {
+		reader, err = decodeFile(source)
+	}
+	if err != nil {
+		file.Close()
+		return WordsFile{}, err
+	}
+
 	return WordsFile{
-		file:      file,
-		separator: separator,
-		comment:   comment,
-		mutex:     &sync.Mutex{},
+		file:    source,
+		name:    name,
+		closer:  file,
+		grammar: config.grammar,
+		state:   newFileState(reader),
 	}, nil
 }
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// newFileState create a new state of WordsFile with decoded content of file
+func newFileState(file io.ReaderAt) *fileState {
+	var state = &fileState{}
+	state.setIndex(&fileIndex{file: file})
+	return state
+}
+
+// setFault store the error, it is safe for concurrent use by multiple goroutines
+func (s *fileState) setFault(err error) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fault = err
+}
+
+// getFault load the error, it is safe for concurrent use by multiple goroutines
+func (s *fileState) getFault() error {
+	if s == nil {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.fault
+}
+
+// setIndex replace the decoded content and the index of names at once, it is safe for concurrent use by multiple goroutines
+func (s *fileState) setIndex(index *fileIndex) {
+	s.index.Store(index)
+}
+
+// getIndex load the decoded content and the index of names without locking, return an empty index if it is not built.
+// It is safe for concurrent use by multiple goroutines.
+func (s *fileState) getIndex() *fileIndex {
+	if s == nil {
+		return &fileIndex{}
+	}
+	if index, ok := s.index.Load().(*fileIndex); ok {
+		return index
+	}
+	return &fileIndex{}
+}
+
+// decodeFile return reader of UTF-8 content of file, a byte order mark of UTF-8 is skipped,
+// and content of UTF-16 with byte order mark is decoded into memory
+func decodeFile(file io.ReaderAt) (io.ReaderAt, error) {
+	var mark = make([]byte, len(internal.ByteOrderMarkUTF8))
+	n, err := file.ReadAt(mark, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	mark = mark[:n]
+
+	switch {
+	case bytes.HasPrefix(mark, internal.ByteOrderMarkUTF8):
+		var size = int64(len(internal.ByteOrderMarkUTF8))
+		return io.NewSectionReader(file, size, math.MaxInt64-size), nil
+	case internal.HasByteOrderMark(mark):
+		data, err := io.ReadAll(io.NewSectionReader(file, 0, math.MaxInt64))
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(internal.DecodeUnicode(data)), nil
+	}
+	return file, nil
+}
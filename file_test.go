@@ -1,9 +1,13 @@
 package gowords_test
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"path"
+	"sync"
 	"testing"
+	"testing/fstest"
 
 	. "github.com/saleh-rahimzadeh/go-words"
 
@@ -73,6 +77,147 @@ func TestNewWordsFile_Instantiation(t *testing.T) {
 	}
 }
 
+func TestNewWordsFileIndexed(t *testing.T) {
+	// Arrange
+	file, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	// Act
+	_, err = NewWordsFileIndexed(file, core.Separator, core.Comment)
+	// Assert
+	if err != nil {
+		t.Errorf("NewWordsFileIndexed() error = %v", err)
+		return
+	}
+}
+
+func TestNewWordsFileIndexed_Instantiation(t *testing.T) {
+	fileDuplicate, err := os.Open(path.Join(path_WORDS, "duplicate_found"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileDuplicate.Close()
+	fileAbsentName, err := os.Open(path.Join(path_WORDS, "invalid_absent_name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileAbsentName.Close()
+	type args struct {
+		file      *os.File
+		separator rune
+		comment   rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check nil file", args{file: nil, separator: core.Separator, comment: core.Comment}, core.ErrFileNil},
+		{"check duplicated", args{file: fileDuplicate, separator: core.Separator, comment: core.Comment}, core.ErrNameDuplicated},
+		{"check absent name", args{file: fileAbsentName, separator: core.Separator, comment: core.Comment}, core.ErrNameNotPresent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWordsFileIndexed(tt.args.file, tt.args.separator, tt.args.comment); !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsFileIndexed() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsFileIndexed_Find(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	w, err := NewWordsFileIndexed(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Errorf("NewWordsFileIndexed() error = %v", err)
+		return
+	}
+	tests := []struct {
+		name      string
+		arg       string
+		wantValue string
+		wantFound bool
+	}{
+		{"found first", "k1", "v1", true},
+		{"found sparse", "k7", "v7", true},
+		{"found empty", "k11", internal.Empty, true},
+		{"notfound", key_NOTFOUND, internal.Empty, false},
+		{"empty", internal.Empty, internal.Empty, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotFound := w.Find(tt.arg)
+			if err := w.Err(); err != nil {
+				t.Errorf("WordsFile.Find() error = %v", err)
+			}
+			if gotValue != tt.wantValue {
+				t.Errorf("WordsFile.Find() gotValue = %v, want %v", gotValue, tt.wantValue)
+			}
+			if gotFound != tt.wantFound {
+				t.Errorf("WordsFile.Find() gotFound = %v, want %v", gotFound, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestNewWordsFS(t *testing.T) {
+	// Arrange
+	fsys := os.DirFS(path_WORDS)
+	// Act
+	w, err := NewWordsFS(fsys, "valid_sparse__source", core.Separator, core.Comment)
+	// Assert
+	if err != nil {
+		t.Errorf("NewWordsFS() error = %v", err)
+		return
+	}
+	defer w.Close()
+	if err := w.CheckError(); err != nil {
+		t.Errorf("WordsFile.CheckError() error = %v", err)
+		return
+	}
+	if got := w.Get("k7"); got != "v7" {
+		t.Errorf("WordsFile.Get() = %v, want %v", got, "v7")
+	}
+}
+
+func TestNewWordsFS_Instantiation(t *testing.T) {
+	fsys := fstest.MapFS{
+		"empty": &fstest.MapFile{},
+		"valid": &fstest.MapFile{Data: []byte("k1=v1")},
+	}
+	type args struct {
+		fsys      fs.FS
+		name      string
+		separator rune
+		comment   rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check nil file system", args{fsys: nil, name: "valid", separator: core.Separator, comment: core.Comment}, core.ErrFileSystemNil},
+		{"check not exist file", args{fsys: fsys, name: "notexist", separator: core.Separator, comment: core.Comment}, fs.ErrNotExist},
+		{"check empty file", args{fsys: fsys, name: "empty", separator: core.Separator, comment: core.Comment}, core.ErrFileEmpty},
+		{"check directory", args{fsys: fsys, name: ".", separator: core.Separator, comment: core.Comment}, core.ErrFileUnsupported},
+		{"check invalid separator delimiters", args{fsys: fsys, name: "valid", separator: 'x', comment: core.Comment}, core.ErrSeparatorIsInvalid},
+		{"check invalid comment delimiters", args{fsys: fsys, name: "valid", separator: core.Separator, comment: 'x'}, core.ErrCommentIsInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWordsFS(tt.args.fsys, tt.args.name, tt.args.separator, tt.args.comment); !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsFS() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestWordsFile_CheckError(t *testing.T) {
 	fileValid, err := os.Open(path.Join(path_WORDS, "valid__want"))
 	if err != nil {
@@ -145,6 +290,68 @@ func TestWordsFile_CheckError(t *testing.T) {
 	}
 }
 
+func TestWordsFile_CheckError_ParseError(t *testing.T) {
+	tests := []struct {
+		name         string
+		file         string
+		want         error
+		wantLine     int
+		wantPrevious int
+	}{
+		{"absent name", "invalid_absent_name", core.ErrNameNotPresent, 2, 0},
+		{"duplicated", "duplicate_found", core.ErrNameDuplicated, 5, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(path.Join(path_WORDS, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+			w, err := NewWordsFile(file, core.Separator, core.Comment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.CheckError()
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.want) {
+				t.Fatalf("WordsFile.CheckError() error = %v, want %v", err, tt.want)
+			}
+			if parseError.Source != file.Name() || parseError.Line != tt.wantLine || parseError.Previous != tt.wantPrevious {
+				t.Errorf("WordsFile.CheckError() error = %+v, want source %v line %v previous %v", parseError, file.Name(), tt.wantLine, tt.wantPrevious)
+			}
+		})
+	}
+}
+
+func TestWordsFile_Validate(t *testing.T) {
+	fileValid, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileValid.Close()
+	wValid, err := NewWordsFile(fileValid, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wValid.Validate(); err != nil {
+		t.Errorf("WordsFile.Validate() error = %v", err)
+	}
+	fileInvalid, err := os.Open(path.Join(path_WORDS, "invalid_multiple"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileInvalid.Close()
+	wInvalid, err := NewWordsFile(fileInvalid, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMultiple(t, wInvalid.Validate(), fileInvalid.Name())
+	if err := (WordsFile{}).Validate(); err == nil {
+		t.Errorf("WordsFile.Validate() got nil error")
+	}
+}
+
 func TestWordsFile_Get(t *testing.T) {
 	file, err := os.Open(path.Join(path_WORDS, "valid__want"))
 	if err != nil {
@@ -208,6 +415,230 @@ func TestWordsFile_Find(t *testing.T) {
 	}
 }
 
+func TestWordsFile_Find_Concurrent(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	wFile, err := NewWordsFile(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wIndexed, err := NewWordsFileIndexed(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		words WordsFile
+	}{
+		{"file", wFile},
+		{"indexed", wIndexed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var group sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				group.Add(1)
+				go func() {
+					defer group.Done()
+					if got, found := tt.words.Find("k7"); !found || got != "v7" {
+						t.Errorf("WordsFile.Find() = %v, %v, want %v, %v", got, found, "v7", true)
+					}
+				}()
+			}
+			group.Wait()
+		})
+	}
+}
+
+func TestWordsFile_CheckError_Concurrent(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	w, err := NewWordsFileIndexed(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var group sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		group.Add(2)
+		go func() {
+			defer group.Done()
+			if err := w.CheckError(); err != nil {
+				t.Errorf("WordsFile.CheckError() error = %v", err)
+			}
+		}()
+		go func() {
+			defer group.Done()
+			if got, found := w.Find("k7"); !found || got != "v7" {
+				t.Errorf("WordsFile.Find() = %v, %v, want %v, %v", got, found, "v7", true)
+			}
+			if got := w.FindPrefix("k7"); got["k7"] != "v7" {
+				t.Errorf("WordsFile.FindPrefix() = %v", got)
+			}
+		}()
+	}
+	group.Wait()
+}
+
+func TestWordsFileIndexed_Changed(t *testing.T) {
+	file, err := os.CreateTemp("", "gowords_TestWordsFileIndexed_Changed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err := file.WriteString("alpha=1\nbeta=2\n"); err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWordsFileIndexed(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteAt([]byte("beta=2\nalpha=1\n"), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if value, found, err := w.FindErr("beta"); !errors.Is(err, core.ErrIndexStale) {
+		t.Errorf("WordsFile.FindErr() = %v, %v, %v, want error %v", value, found, err, core.ErrIndexStale)
+	}
+	if got := w.FindPrefix("b"); len(got) != 0 || !errors.Is(w.Err(), core.ErrIndexStale) {
+		t.Errorf("WordsFile.FindPrefix() = %v, error = %v, want error %v", got, w.Err(), core.ErrIndexStale)
+	}
+
+	if err := w.CheckError(); err != nil {
+		t.Fatalf("WordsFile.CheckError() error = %v", err)
+	}
+	for name, want := range map[string]string{"alpha": "1", "beta": "2"} {
+		if value, found, err := w.FindErr(name); err != nil || !found || value != want {
+			t.Errorf("WordsFile.FindErr(%q) = %v, %v, %v, want %v", name, value, found, err, want)
+		}
+	}
+	if got := w.FindPrefix("b"); len(got) != 1 || got["beta"] != "2" {
+		t.Errorf("WordsFile.FindPrefix() = %v", got)
+	}
+}
+
+func TestWordsFile_Changed_UTF16(t *testing.T) {
+	utf16 := func(source string) []byte {
+		data := []byte{0xFF, 0xFE}
+		for _, character := range []byte(source) {
+			data = append(data, character, 0)
+		}
+		return data
+	}
+	constructors := map[string]func(*os.File, rune, rune, ...Option) (WordsFile, error){
+		"WordsFile":         NewWordsFile,
+		"WordsFile indexed": NewWordsFileIndexed,
+	}
+	for name, constructor := range constructors {
+		t.Run(name, func(t *testing.T) {
+			file, err := os.CreateTemp("", "gowords_TestWordsFile_Changed_UTF16")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(file.Name())
+			defer file.Close()
+			if _, err := file.Write(utf16("k1=a\nk2=b\n")); err != nil {
+				t.Fatal(err)
+			}
+			w, err := constructor(file, core.Separator, core.Comment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := file.WriteAt(utf16("k2=c\nk1=d\n"), 0); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := w.CheckError(); err != nil {
+				t.Fatalf("WordsFile.CheckError() error = %v", err)
+			}
+			for name, want := range map[string]string{"k1": "d", "k2": "c"} {
+				if value, found, err := w.FindErr(name); err != nil || !found || value != want {
+					t.Errorf("WordsFile.FindErr(%q) = %v, %v, %v, want %v", name, value, found, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWordsFile_FindErr(t *testing.T) {
+	fileValid, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileValid.Close()
+	wValid, err := NewWordsFile(fileValid, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileInvalid, err := os.Open(path.Join(path_WORDS, "invalid_absent_name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileInvalid.Close()
+	wInvalid, err := NewWordsFile(fileInvalid, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileClosed, err := os.Open(path.Join(path_WORDS, "valid__want"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wClosed, err := NewWordsFile(fileClosed, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileClosed.Close()
+	tests := []struct {
+		name      string
+		words     WordsFile
+		arg       string
+		wantValue string
+		wantFound bool
+		wantErr   error
+	}{
+		{"found", wValid, "k7", "v7", true, nil},
+		{"notfound", wValid, key_NOTFOUND, internal.Empty, false, nil},
+		{"invalid", wInvalid, key_NOTFOUND, internal.Empty, false, core.ErrNameNotPresent},
+		{"closed file", wClosed, "k1", internal.Empty, false, os.ErrClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotFound, gotErr := tt.words.FindErr(tt.arg)
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("WordsFile.FindErr() error = %v, want %v", gotErr, tt.wantErr)
+			}
+			if gotValue != tt.wantValue {
+				t.Errorf("WordsFile.FindErr() gotValue = %v, want %v", gotValue, tt.wantValue)
+			}
+			if gotFound != tt.wantFound {
+				t.Errorf("WordsFile.FindErr() gotFound = %v, want %v", gotFound, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestWordsFile_Err(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "invalid_absent_name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	w, err := NewWordsFile(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Find(key_NOTFOUND)
+	if err := w.Err(); !errors.Is(err, core.ErrNameNotPresent) {
+		t.Errorf("WordsFile.Err() error = %v, want %v", err, core.ErrNameNotPresent)
+	}
+}
+
 func TestWordsFile_FindUnsafe(t *testing.T) {
 	file, err := os.Open(path.Join(path_WORDS, "valid_sparse__source"))
 	if err != nil {
@@ -308,6 +739,26 @@ func BenchmarkWordsFile(b *testing.B) {
 	}
 }
 
+func BenchmarkWordsFileParallel(b *testing.B) {
+	file, err := os.Open(path.Join(path_BENCHMARK, "normalization__large"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer file.Close()
+	w, err := NewWordsFile(file, core.Separator, core.Comment)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, found := w.Find("k1000")
+			if !found {
+				b.Fatal(benchmark_KEY_NOTFOUND)
+			}
+		}
+	})
+}
+
 func BenchmarkWordsFileUnsafe(b *testing.B) {
 	file, err := os.Open(path.Join(path_BENCHMARK, "normalization__large"))
 	if err != nil {
@@ -328,3 +779,76 @@ func BenchmarkWordsFileUnsafe(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkWordsFileIndexed(b *testing.B) {
+	file, err := os.Open(path.Join(path_BENCHMARK, "normalization__large"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer file.Close()
+	w, err := NewWordsFileIndexed(file, core.Separator, core.Comment)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		_, found := w.Find("k1000")
+		if err := w.Err(); err != nil {
+			b.Fatal(err)
+		}
+		if !found {
+			b.Fatal(benchmark_KEY_NOTFOUND)
+		}
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	want := map[string]string{
+		"k1":   "v1",
+		"سلام": "دنیا",
+		"k3":   "line 1\nline 2",
+	}
+	for _, name := range []string{"utf8_bom", "utf16le", "utf16be", "crlf", "cr", "mixed"} {
+		t.Run(name, func(t *testing.T) {
+			storages := storagesWith(t, path.Join("encoding", name), WithMultiline())
+			w, err := NewWordsFS(os.DirFS(path.Join(path_WORDS, "encoding")), name, core.Separator, core.Comment, WithMultiline())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.Close()
+			storages["WordsFile of file system"] = w
+			findAll(t, storages, want)
+
+			if err := ValidateFS(os.DirFS(path.Join(path_WORDS, "encoding")), name, core.Separator, core.Comment, WithMultiline()); err != nil {
+				t.Errorf("ValidateFS() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodings_Includes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"main":    {Data: []byte("\xEF\xBB\xBFk1 = v1\r\n@include utf16\r\n")},
+		"utf16":   {Data: []byte{0xFF, 0xFE, 'k', 0, '2', 0, '=', 0, 0x33, 0x06, '\r', 0, 'k', 0, '3', 0, '=', 0, 'v', 0}},
+		"invalid": {Data: []byte("k1 = v1\rinvalid\rk3 = v3")},
+	}
+	w, err := NewWordsFS(fsys, "main", core.Separator, core.Comment, WithIncludes(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	wIndexed, err := NewWordsFS(fsys, "main", core.Separator, core.Comment, WithIncludes(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wIndexed.Close()
+	if err := wIndexed.CheckError(); err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsFile": w, "WordsFile checked": wIndexed}, map[string]string{"k1": "v1", "k2": "س", "k3": "v"})
+
+	err = ValidateFS(fsys, "invalid", core.Separator, core.Comment)
+	var parseErrors core.ParseErrors
+	if !errors.As(err, &parseErrors) || len(parseErrors) != 1 || parseErrors[0].Line != 2 {
+		t.Errorf("ValidateFS() error = %v, want error at line %v", err, 2)
+	}
+}
@@ -0,0 +1,147 @@
+package gowords
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromPO create a new instance of WordsCollection by reading a gettext PO catalog from reader.
+// The name of each message is its msgid, or msgctxt and msgid joined by "core.ContextSeparator".
+// Plural forms are named by index like "msgid[1]", and the first form is also named by msgid.
+// The plural source text of msgid_plural is named like "msgid[plural]".
+// The header, fuzzy and untranslated messages are skipped.
+func NewWordsCollectionFromPO(reader io.Reader) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParsePO) })
+}
+
+// NewWordsRepositoryFromPO create a new instance of WordsRepository by reading a gettext PO catalog from reader,
+// same as "NewWordsCollectionFromPO"
+func NewWordsRepositoryFromPO(reader io.Reader) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParsePO) })
+}
+
+// NewWordsCollectionFromMO create a new instance of WordsCollection by reading a compiled gettext MO catalog from reader,
+// names are same as "NewWordsCollectionFromPO"
+func NewWordsCollectionFromMO(reader io.Reader) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParseMO) })
+}
+
+// NewWordsRepositoryFromMO create a new instance of WordsRepository by reading a compiled gettext MO catalog from reader,
+// names are same as "NewWordsCollectionFromPO"
+func NewWordsRepositoryFromMO(reader io.Reader) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParseMO) })
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// poMessage a message of PO catalog being written, a plural message has plural source text and translations of forms
+type poMessage struct {
+	name   string
+	value  string
+	plural *string
+	forms  map[int]string
+}
+
+// WritePO write names and values of words as messages of a gettext PO catalog in order of source, for translation.
+// A name containing "core.ContextSeparator" is written as msgctxt and msgid.
+// Names of plural forms like "msgid[1]" are grouped with plural source text of "msgid[plural]" to msgid_plural
+// and msgstr[N] at position of the first of them, and the first form named by msgid is not written again,
+// so plural messages loaded by "NewWordsCollectionFromPO" are written back same.
+// Words must be Enumerable.
+func WritePO(writer io.Writer, words Words) error {
+	var (
+		pairs    [][2]string
+		messages []*poMessage
+		plurals  map[string]*poMessage = make(map[string]*poMessage)
+	)
+	err := enumerate(words, func(name string, value string) bool {
+		pairs = append(pairs, [2]string{name, value})
+		if base, form, found := internal.SplitFormName(name); found && form == internal.PluralForm {
+			plurals[base] = &poMessage{name: base, plural: &value, forms: make(map[int]string)}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	var placed = make(map[*poMessage]bool)
+	var place = func(message *poMessage) {
+		if !placed[message] {
+			placed[message] = true
+			messages = append(messages, message)
+		}
+	}
+	for _, pair := range pairs {
+		var name, value = pair[0], pair[1]
+		if message, found := plurals[name]; found {
+			// The first form is also named by msgid
+			if _, found := message.forms[0]; !found {
+				message.forms[0] = value
+			}
+			place(message)
+			continue
+		}
+		if base, form, found := internal.SplitFormName(name); found && plurals[base] != nil {
+			if form == internal.PluralForm {
+				place(plurals[base])
+				continue
+			}
+			if index, err := strconv.Atoi(form); err == nil && index >= 0 && internal.PluralName(base, index) == name {
+				plurals[base].forms[index] = value
+				place(plurals[base])
+				continue
+			}
+		}
+		messages = append(messages, &poMessage{name: name, value: value})
+	}
+
+	var buffer = bufio.NewWriter(writer)
+	buffer.WriteString("msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	for _, message := range messages {
+		buffer.WriteString("\n")
+		var name = message.name
+		if context, id, found := strings.Cut(name, core.ContextSeparator); found {
+			writePOString(buffer, "msgctxt", context)
+			name = id
+		}
+		writePOString(buffer, "msgid", name)
+		if message.plural == nil {
+			writePOString(buffer, "msgstr", message.value)
+			continue
+		}
+		writePOString(buffer, "msgid_plural", *message.plural)
+		var forms = make([]int, 0, len(message.forms))
+		for form := range message.forms {
+			forms = append(forms, form)
+		}
+		sort.Ints(forms)
+		for _, form := range forms {
+			writePOString(buffer, "msgstr["+strconv.Itoa(form)+"]", message.forms[form])
+		}
+	}
+	return buffer.Flush()
+}
+
+// writePOString write a keyword and its string, a multi-line string is written in lines after an empty string
+func writePOString(buffer *bufio.Writer, keyword string, text string) {
+	buffer.WriteString(keyword + " ")
+	if !strings.Contains(strings.TrimSuffix(text, internal.NewLine), internal.NewLine) {
+		buffer.WriteString(internal.QuotePO(text) + internal.NewLine)
+		return
+	}
+	buffer.WriteString("\"\"\n")
+	for _, line := range strings.SplitAfter(text, internal.NewLine) {
+		if line != internal.Empty {
+			buffer.WriteString(internal.QuotePO(line) + internal.NewLine)
+		}
+	}
+}
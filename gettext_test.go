@@ -0,0 +1,254 @@
+package gowords_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+// buildMO create a MO catalog of pairs of original and translation strings
+func buildMO(order binary.ByteOrder, pairs [][2]string) []byte {
+	var (
+		count   int    = len(pairs)
+		tables  int    = 28
+		strings int    = tables + count*16
+		header  []byte = make([]byte, strings)
+		data    bytes.Buffer
+	)
+	order.PutUint32(header[0:], 0x950412de)
+	order.PutUint32(header[8:], uint32(count))
+	order.PutUint32(header[12:], uint32(tables))
+	order.PutUint32(header[16:], uint32(tables+count*8))
+	for column := 0; column < 2; column++ {
+		for index, pair := range pairs {
+			var entry = tables + column*count*8 + index*8
+			order.PutUint32(header[entry:], uint32(len(pair[column])))
+			order.PutUint32(header[entry+4:], uint32(strings+data.Len()))
+			data.WriteString(pair[column])
+			data.WriteByte(0)
+		}
+	}
+	return append(header, data.Bytes()...)
+}
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromPO(t *testing.T) {
+	want := map[string]string{
+		"Hello":                                 "سلام",
+		"menu" + core.ContextSeparator + "Open": "باز کردن",
+		"Open":                                  "بازکردن",
+		"%d file":                               "%d فایل",
+		"%d file[0]":                            "%d فایل",
+		"%d file[1]":                            "%d فایل‌ها",
+		"%d file[plural]":                       "%d files",
+		"Multi-line message":                    "First line\nSecond \"line\"",
+		"No blank line":                         "Adjacent",
+	}
+	open := func() io.Reader {
+		file, err := os.Open(path.Join(path_WORDS, "messages.po"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { file.Close() })
+		return file
+	}
+	wCollection, err := NewWordsCollectionFromPO(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromPO(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	storages := map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}
+	findAll(t, storages, want)
+	for kind, words := range storages {
+		for _, name := range []string{"Fuzzy", "Untranslated", "Obsolete", "%d files"} {
+			if value, found := words.Find(name); found {
+				t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, "", false)
+			}
+		}
+	}
+}
+
+func TestNewWordsFromPO_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantErr  error
+		wantLine int
+	}{
+		{"unknown keyword", "msgid \"k1\"\nmsgval \"v1\"", core.ErrPOInvalid, 2},
+		{"string without keyword", "\"v1\"", core.ErrPOInvalid, 1},
+		{"translation without id", "msgstr \"v1\"", core.ErrPOInvalid, 1},
+		{"plural form without plural", "msgid \"k1\"\nmsgstr[0] \"v1\"", core.ErrPOInvalid, 2},
+		{"duplicated form", "msgid \"k1\"\nmsgid_plural \"k1s\"\nmsgstr[0] \"v1\"\nmsgstr[0] \"v2\"", core.ErrPOInvalid, 4},
+		{"not quoted", "msgid k1\nmsgstr \"v1\"", core.ErrQuoteInvalid, 1},
+		{"invalid escape", "msgid \"k1\"\nmsgstr \"v\\q\"", core.ErrEscapeInvalid, 2},
+		{"context without id", "msgctxt \"c1\"\n\nmsgid \"k1\"", core.ErrNameNotPresent, 1},
+		{"duplicated name", "msgid \"k1\"\nmsgstr \"v1\"\n\nmsgid \"k1\"\nmsgstr \"v2\"", core.ErrNameDuplicated, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromPO(strings.NewReader(tt.source))
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewWordsCollectionFromPO() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine {
+				t.Errorf("NewWordsCollectionFromPO() error = %+v, want line %v", parseError, tt.wantLine)
+			}
+		})
+	}
+
+	if _, err := NewWordsCollectionFromPO(nil); !errors.Is(err, core.ErrReaderNil) {
+		t.Errorf("NewWordsCollectionFromPO() error = %v, want %v", err, core.ErrReaderNil)
+	}
+}
+
+func TestNewWordsFromMO(t *testing.T) {
+	pairs := [][2]string{
+		{"", "Content-Type: text/plain; charset=UTF-8\n"},
+		{"Hello", "سلام"},
+		{"menu" + core.ContextSeparator + "Open", "باز کردن"},
+		{"%d file\x00%d files", "%d فایل\x00%d فایل‌ها"},
+		{"Untranslated", ""},
+	}
+	want := map[string]string{
+		"Hello":                                 "سلام",
+		"menu" + core.ContextSeparator + "Open": "باز کردن",
+		"%d file":                               "%d فایل",
+		"%d file[0]":                            "%d فایل",
+		"%d file[1]":                            "%d فایل‌ها",
+		"%d file[plural]":                       "%d files",
+	}
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		data := buildMO(order, pairs)
+		wCollection, err := NewWordsCollectionFromMO(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		wRepository, err := NewWordsRepositoryFromMO(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		findAll(t, map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}, want)
+		if value, found := wCollection.Find("Untranslated"); found {
+			t.Errorf("WordsCollection.Find() = %q, %v, want %q, %v", value, found, "", false)
+		}
+	}
+}
+
+func TestNewWordsFromMO_Invalid(t *testing.T) {
+	valid := buildMO(binary.LittleEndian, [][2]string{{"k1", "v1"}})
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"empty", []byte{}, core.ErrMOInvalid},
+		{"magic", append([]byte{1, 2, 3, 4}, valid[4:]...), core.ErrMOInvalid},
+		{"truncated", valid[:len(valid)-4], core.ErrMOInvalid},
+		{"duplicated name", buildMO(binary.LittleEndian, [][2]string{{"k1", "v1"}, {"k1", "v2"}}), core.ErrNameDuplicated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWordsCollectionFromMO(bytes.NewReader(tt.data)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWordsCollectionFromMO() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWritePO(t *testing.T) {
+	const source string = "Hello = سلام\nmenu.open = \"Open \\\"file\\\"\"\nlines = First\\nSecond"
+	const want string = `msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+
+msgid "Hello"
+msgstr "سلام"
+
+msgid "menu.open"
+msgstr "Open \"file\""
+
+msgid "lines"
+msgstr ""
+"First\n"
+"Second"
+`
+	for kind, words := range storagesOf(t, source, WithEscapes()) {
+		var buffer bytes.Buffer
+		if err := WritePO(&buffer, words); err != nil {
+			t.Fatalf("%s WritePO() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WritePO() = %v, want %v", kind, buffer.String(), want)
+		}
+		w, err := NewWordsCollectionFromPO(&buffer)
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromPO() error = %v", kind, err)
+		}
+		findAll(t, map[string]Words{kind: w}, map[string]string{"Hello": "سلام", "menu.open": `Open "file"`, "lines": "First\nSecond"})
+	}
+}
+
+func TestWritePO_Plural(t *testing.T) {
+	const source string = `msgid "Hello"
+msgstr "سلام"
+
+msgctxt "menu"
+msgid "apple"
+msgid_plural "apples"
+msgstr[0] "سیب"
+msgstr[1] "سیب‌ها"
+
+msgid "Bye"
+msgstr "خداحافظ"
+`
+	const want string = `msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+
+` + source
+	w, err := NewWordsRepositoryFromPO(strings.NewReader(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsRepository": w}, map[string]string{
+		"menu" + core.ContextSeparator + "apple[plural]": "apples",
+		"menu" + core.ContextSeparator + "apple[1]":      "سیب‌ها",
+	})
+	var buffer bytes.Buffer
+	if err := WritePO(&buffer, w); err != nil {
+		t.Fatal(err)
+	}
+	if buffer.String() != want {
+		t.Errorf("WritePO() = %v, want %v", buffer.String(), want)
+	}
+}
+
+func TestWritePO_Context(t *testing.T) {
+	w, err := NewWordsCollectionFromPO(strings.NewReader("msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"باز کردن\""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	if err := WritePO(&buffer, w); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buffer.String(), "msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"باز کردن\"\n") {
+		t.Errorf("WritePO() = %v, want msgctxt of %q", buffer.String(), "menu")
+	}
+}
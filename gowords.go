@@ -6,6 +6,15 @@
 
 package gowords
 
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
 //──────────────────────────────────────────────────────────────────────────────────────────────────
 
 // Words the interface to specify required methods to get and find words
@@ -15,3 +24,170 @@ type Words interface {
 	// Find search for a name then return value and `true` if found, else return empty string and `false`
 	Find(string) (string, bool)
 }
+
+// FallibleWords the interface to specify methods to find words with reporting errors, for sources which may fail on searching
+type FallibleWords interface {
+	Words
+	// FindErr search for a name then return value and `true` if found, else return empty string and `false`,
+	// also return the error occurred in reading or parsing source in this call
+	FindErr(string) (string, bool, error)
+}
+
+// Enumerable the interface to specify methods to list all names and values of words in order of source,
+// implemented by all storages, and by WithSuffix, WithPrefix and DoAnnotation of Enumerable words
+type Enumerable interface {
+	Words
+	// Len return number of names
+	Len() int
+	// Keys return all names in order of source
+	Keys() []string
+	// Range call yield for each name and value in order of source, stop when yield return `false`
+	Range(yield func(name string, value string) bool)
+}
+
+// PrefixWords the interface to specify methods to search for all names under a prefix, such as "errors.auth.",
+// by a sorted index of names instead of reading all names
+type PrefixWords interface {
+	Words
+	// FindPrefix return all names which start with prefix and their values
+	FindPrefix(prefix string) map[string]string
+	// ScanPrefix call yield for each name which start with prefix and its value in sorted order of names,
+	// stop when yield return `false`
+	ScanPrefix(prefix string, yield func(name string, value string) bool)
+}
+
+// walker the interface of storages which can read all names and values in order of source and report errors of reading
+type walker interface {
+	// walk call yield for each name and value, stop when yield return `false`
+	walk(yield func(name string, value string) bool) error
+}
+
+// normalizer the interface of words which normalize searched names
+type normalizer interface {
+	// normalization return the function to normalize names of words, nil if names are kept as is
+	normalization() func(string) string
+}
+
+// normalizationOf return the function to normalize names of words, nil if words does not normalize names
+func normalizationOf(words Words) func(string) string {
+	if source, ok := words.(normalizer); ok {
+		return source.normalization()
+	}
+	return nil
+}
+
+// enumerate call yield for each name and value of words in order of source, stop when yield return `false`.
+// Return "core.ErrWordsNotEnumerable" if words is not Enumerable, and errors of reading source of walkers.
+func enumerate(words Words, yield func(name string, value string) bool) error {
+	switch source := words.(type) {
+	case walker:
+		return source.walk(yield)
+	case Enumerable:
+		source.Range(yield)
+		return nil
+	}
+	return core.ErrWordsNotEnumerable
+}
+
+// keysOf return all names of walker in order of source
+func keysOf(source walker) ([]string, error) {
+	var keys []string
+	err := source.walk(func(name string, _ string) bool {
+		keys = append(keys, name)
+		return true
+	})
+	return keys, err
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWords create a new instance of storage of words from source by options, the single entry point for all behaviours
+// of parsing. The storage is WordsCollection by default or the kind of "WithStorage" option.
+// Delimiters are "core.Separator" and "core.Comment" by default or delimiters of "WithSeparator" and "WithComments" options.
+func NewWords(source string, options ...Option) (Words, error) {
+	config, err := configure(core.Separator, core.Comment, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var words Words
+	switch config.storage {
+	case core.StorageCollection:
+		words, err = newWordsCollection(source, internal.Empty, core.Separator, core.Comment, options)
+	case core.StorageRepository:
+		words, err = newWordsRepository(source, internal.Empty, core.Separator, core.Comment, options)
+	case core.StorageSorted:
+		words, err = newWordsSorted(source, internal.Empty, core.Separator, core.Comment, options)
+	default:
+		err = core.ErrStorageInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// collectionFrom create a new instance of WordsCollection from records of decode, for sources of other formats
+func collectionFrom(decode func() ([]internal.Record, error)) (WordsCollection, error) {
+	records, err := decode()
+	if err != nil {
+		return WordsCollection{}, err
+	}
+	return collectionOf(records), nil
+}
+
+// repositoryFrom create a new instance of WordsRepository from records of decode, for sources of other formats.
+// Names of other formats may contain any character such as the separator, so they are escaped.
+func repositoryFrom(decode func() ([]internal.Record, error)) (WordsRepository, error) {
+	records, err := decode()
+	if err != nil {
+		return WordsRepository{}, err
+	}
+	return repositoryOf(records, string(core.Separator), true), nil
+}
+
+// readSource read whole source from reader and parse its records
+func readSource(reader io.Reader, parse func([]byte, string) ([]internal.Record, error)) ([]internal.Record, error) {
+	if reader == nil {
+		return nil, core.ErrReaderNil
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse(data, internal.SourceName(reader))
+}
+
+// collectPrefix return a map of all names and values which scan yield, for "FindPrefix" methods
+func collectPrefix(scan func(yield func(name string, value string) bool)) map[string]string {
+	var pairs = make(map[string]string)
+	scan(func(name string, value string) bool {
+		pairs[name] = value
+		return true
+	})
+	return pairs
+}
+
+// scanSorted call yield for each name which start with prefix and its value of all names of walk in sorted order of names,
+// stop when yield return `false`, for searching by prefix without an index
+func scanSorted(walk func(yield func(name string, value string) bool) error, prefix string, yield func(name string, value string) bool) error {
+	var pairs [][2]string
+	err := walk(func(name string, value string) bool {
+		if strings.HasPrefix(name, prefix) {
+			pairs = append(pairs, [2]string{name, value})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(pairs, func(i int, j int) bool { return pairs[i][0] < pairs[j][0] })
+	for _, pair := range pairs {
+		if !yield(pair[0], pair[1]) {
+			break
+		}
+	}
+	return nil
+}
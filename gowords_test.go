@@ -0,0 +1,200 @@
+package gowords_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWords(t *testing.T) {
+	const source string = "// comment\nTitle => MyApp  \n# comment\nsize => 10\ntitle => Override"
+	options := []Option{
+		WithSeparator("=>"),
+		WithComments("#", "//"),
+		WithTrim(core.TrimLeading),
+		WithDuplicates(core.DuplicatesLast),
+		WithNormalizer(strings.ToLower),
+	}
+	tests := []struct {
+		name    string
+		storage core.Storage
+		want    string
+	}{
+		{"collection", core.StorageCollection, "gowords.WordsCollection"},
+		{"repository", core.StorageRepository, "gowords.WordsRepository"},
+		{"sorted", core.StorageSorted, "gowords.WordsSorted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, err := NewWords(source, append(options, WithStorage(tt.storage))...)
+			if err != nil {
+				t.Fatalf("NewWords() error = %v", err)
+			}
+			if kind := fmt.Sprintf("%T", words); kind != tt.want {
+				t.Errorf("NewWords() = %v, want %v", kind, tt.want)
+			}
+			if value, found := words.Find("TITLE"); value != "Override" || !found {
+				t.Errorf("Find() = %q, %v, want %q, %v", value, found, "Override", true)
+			}
+			if value, found := words.Find("size"); value != "10" || !found {
+				t.Errorf("Find() = %q, %v, want %q, %v", value, found, "10", true)
+			}
+		})
+	}
+}
+
+func TestNewWords_Default(t *testing.T) {
+	words, err := NewWords("title = MyApp\n# comment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := words.(WordsCollection); !ok {
+		t.Errorf("NewWords() = %T, want WordsCollection", words)
+	}
+	if value := words.Get("title"); value != "MyApp" {
+		t.Errorf("Get() = %q, want %q", value, "MyApp")
+	}
+}
+
+func TestNewWords_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		options []Option
+		wantErr error
+	}{
+		{"empty source", "  ", nil, core.ErrWordsEmpty},
+		{"invalid storage", "k = v", []Option{WithStorage(core.Storage(-1))}, core.ErrStorageInvalid},
+		{"invalid separator", "k = v", []Option{WithSeparator("a")}, core.ErrDelimiterInvalid},
+		{"duplicated name", "k = v\nk = w", []Option{WithStorage(core.StorageRepository)}, core.ErrNameDuplicated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, err := NewWords(tt.source, tt.options...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWords() error = %v, want %v", err, tt.wantErr)
+			}
+			if words != nil {
+				t.Errorf("NewWords() = %v, want nil", words)
+			}
+		})
+	}
+}
+
+func TestEnumerable(t *testing.T) {
+	const source string = "# comment\nzeta = z\nalpha = a\n\nmid = m=n\n"
+	wantKeys := []string{"zeta", "alpha", "mid"}
+	wantValues := []string{"z", "a", "m=n"}
+	for kind, words := range storagesOf(t, source) {
+		enumerable, ok := words.(Enumerable)
+		if !ok {
+			t.Fatalf("%s is not Enumerable", kind)
+		}
+		if got := enumerable.Len(); got != len(wantKeys) {
+			t.Errorf("%s.Len() = %v, want %v", kind, got, len(wantKeys))
+		}
+		if got := enumerable.Keys(); !reflect.DeepEqual(got, wantKeys) {
+			t.Errorf("%s.Keys() = %q, want %q", kind, got, wantKeys)
+		}
+		var keys, values []string
+		enumerable.Range(func(name string, value string) bool {
+			keys = append(keys, name)
+			values = append(values, value)
+			return true
+		})
+		if !reflect.DeepEqual(keys, wantKeys) || !reflect.DeepEqual(values, wantValues) {
+			t.Errorf("%s.Range() = %q, %q, want %q, %q", kind, keys, values, wantKeys, wantValues)
+		}
+		var count int
+		enumerable.Range(func(string, string) bool {
+			count++
+			return count < 2
+		})
+		if count != 2 {
+			t.Errorf("%s.Range() called yield %v times after stop, want %v", kind, count, 2)
+		}
+	}
+}
+
+func TestEnumerable_Keys(t *testing.T) {
+	w, err := NewWordsCollection("k1 = v1\nk2 = v2", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := w.Keys()
+	keys[0] = "changed"
+	if got := w.Keys(); got[0] != "k1" {
+		t.Errorf("WordsCollection.Keys() = %q, want a copy of names", got)
+	}
+}
+
+func TestPrefixWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		want   []string
+	}{
+		{"namespace", "errors.auth.", []string{"errors.auth.denied", "errors.auth.expired"}},
+		{"partial", "errors.auth", []string{"errors.auth.denied", "errors.auth.expired", "errors.authority.missing"}},
+		{"parent", "errors", []string{"errors", "errors.auth.denied", "errors.auth.expired", "errors.authority.missing", "errors.notfound"}},
+		{"all", "", []string{"errors", "errors.auth.denied", "errors.auth.expired", "errors.authority.missing", "errors.notfound", "title"}},
+		{"not found", "messages.", nil},
+		{"after all names", "zzz", nil},
+	}
+	storages := storagesWith(t, "prefix", WithSections())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for kind, words := range storages {
+				prefixed, ok := words.(PrefixWords)
+				if !ok {
+					t.Fatalf("%s is not PrefixWords", kind)
+				}
+				var got []string
+				prefixed.ScanPrefix(tt.prefix, func(name string, value string) bool {
+					if value != words.Get(name) {
+						t.Errorf("%s.ScanPrefix() value of %q = %q, want %q", kind, name, value, words.Get(name))
+					}
+					got = append(got, name)
+					return true
+				})
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s.ScanPrefix(%q) = %q, want %q", kind, tt.prefix, got, tt.want)
+				}
+				if pairs := prefixed.FindPrefix(tt.prefix); len(pairs) != len(tt.want) {
+					t.Errorf("%s.FindPrefix(%q) = %v, want %v names", kind, tt.prefix, pairs, len(tt.want))
+				}
+			}
+		})
+	}
+}
+
+func TestPrefixWords_Stop(t *testing.T) {
+	for kind, words := range storagesWith(t, "prefix", WithSections()) {
+		var got []string
+		words.(PrefixWords).ScanPrefix("errors.", func(name string, _ string) bool {
+			got = append(got, name)
+			return len(got) < 2
+		})
+		if want := []string{"errors.auth.denied", "errors.auth.expired"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.ScanPrefix() = %q, want %q", kind, got, want)
+		}
+	}
+}
+
+func TestPrefixWords_Folding(t *testing.T) {
+	for kind, words := range storagesOf(t, "Errors.Auth = Auth\nerrors.NotFound = Not found\nTitle = MyApp", WithFolding(core.FoldCase)) {
+		pairs := words.(PrefixWords).FindPrefix("ERRORS.")
+		if want := map[string]string{"errors.auth": "Auth", "errors.notfound": "Not found"}; !reflect.DeepEqual(pairs, want) {
+			t.Errorf("%s.FindPrefix() = %v, want %v", kind, pairs, want)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package internal
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined elements and attributes of Android string resources
+const (
+	androidResources string = "resources"
+	androidString    string = "string"
+	androidArray     string = "string-array"
+	androidPlurals   string = "plurals"
+	androidItem      string = "item"
+	androidName      string = "name"
+	androidQuantity  string = "quantity"
+	xliffNamespace   string = "urn:oasis:names:tc:xliff:document:1.2"
+	xliffPrefix      string = "xliff"
+)
+
+// Quantities of plural forms of Android and Apple resources, by CLDR plural categories
+var Quantities = []string{"zero", "one", "two", "few", "many", "other"}
+
+// FormName return name of form of id, such as "apple[1]" or "apple[one]"
+func FormName(id string, form string) string {
+	return id + "[" + form + "]"
+}
+
+// SplitFormName split name of form to id and form, return `false` if name is not a name of form
+func SplitFormName(name string) (string, string, bool) {
+	if !strings.HasSuffix(name, "]") {
+		return Empty, Empty, false
+	}
+	index := strings.LastIndexByte(name, '[')
+	if index < 1 || index == len(name)-2 {
+		return Empty, Empty, false
+	}
+	return name[:index], name[index+1 : len(name)-1], true
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseAndroid read records of Android string resources like `<string name="title">MyApp</string>`.
+// Items of `<string-array>` are named by index like "planets[0]", and items of `<plurals>` by quantity like "songs[one]".
+// Values are unescaped by "UnescapeAndroid" except tags of markup such as `<b>` which are kept as is, and `<xliff:g>` tags are removed.
+// Other resources such as `<integer>` are skipped.
+func ParseAndroid(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var reader = newXMLReader(data, source)
+	if _, err := reader.root(androidResources); err != nil {
+		return nil, err
+	}
+
+	var value = func(key string) error {
+		var record = reader.record(key, Empty)
+		text, err := reader.text(stripXLIFF)
+		if err != nil {
+			return err
+		}
+		record.Value, err = unescapeAndroid(text, reader.tags)
+		if err != nil {
+			return &core.ParseError{Source: source, Line: record.Line, Column: record.Column, Text: record.Text, Err: err}
+		}
+		return reader.add(record)
+	}
+
+	err := reader.children(func(element xml.StartElement) error {
+		var kind = element.Name.Local
+		if kind != androidString && kind != androidArray && kind != androidPlurals {
+			return reader.skip()
+		}
+		name, found := attribute(element, androidName)
+		if name = strings.TrimSpace(name); !found || name == Empty {
+			return reader.fault(core.ErrNameNotPresent)
+		}
+		if kind == androidString {
+			return value(name)
+		}
+
+		var index int
+		return reader.children(func(item xml.StartElement) error {
+			if item.Name.Local != androidItem {
+				return reader.fault(core.ErrXMLInvalid)
+			}
+			var form = strconv.Itoa(index)
+			if kind == androidPlurals {
+				form, _ = attribute(item, androidQuantity)
+				if !IsQuantity(form) {
+					return reader.fault(core.ErrXMLInvalid)
+				}
+			}
+			index++
+			return value(FormName(name, form))
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reader.records, nil
+}
+
+// stripXLIFF return `true` for elements of XLIFF namespace such as `<xliff:g>`
+func stripXLIFF(name xml.Name) bool {
+	return name.Space == xliffNamespace || name.Space == xliffPrefix
+}
+
+// IsQuantity return `true` if form is a CLDR plural category
+func IsQuantity(form string) bool {
+	for _, quantity := range Quantities {
+		if form == quantity {
+			return true
+		}
+	}
+	return false
+}
+
+// UnescapeAndroid decode text of an Android string resource by rules of Android:
+// unquoted whitespace is collapsed to a space and trimmed, double quotes are removed and keep whitespace of their content,
+// and escape sequences "\n", "\t", "\uXXXX" and backslash followed by any other character are decoded.
+func UnescapeAndroid(text string) (string, error) {
+	return unescapeAndroid(text, nil)
+}
+
+// unescapeAndroid decode text of an Android string resource same as "UnescapeAndroid",
+// except ranges of tags of markup which are written as is
+func unescapeAndroid(text string, tags [][]int) (string, error) {
+	var (
+		builder strings.Builder
+		quoted  bool
+		space   bool // A collapsed whitespace is pending
+	)
+	var write = func(character rune) {
+		if space && builder.Len() > 0 {
+			builder.WriteByte(' ')
+		}
+		space = false
+		builder.WriteRune(character)
+	}
+
+	var decode = func(text string) error {
+		var runes = []rune(text)
+		for index := 0; index < len(runes); index++ {
+			var character = runes[index]
+			switch {
+			case character == rune(EscapeByte):
+				index++
+				if index == len(runes) {
+					return core.ErrEscapeInvalid
+				}
+				switch runes[index] {
+				case 'n':
+					write('\n')
+				case 't':
+					write('\t')
+				case 'u':
+					if index+EscapeHexSize >= len(runes) {
+						return core.ErrEscapeInvalid
+					}
+					code, err := strconv.ParseUint(string(runes[index+1:index+1+EscapeHexSize]), 16, 32)
+					if err != nil {
+						return core.ErrEscapeInvalid
+					}
+					write(rune(code))
+					index += EscapeHexSize
+				default:
+					write(runes[index])
+				}
+			case character == rune(QuoteByte):
+				quoted = !quoted
+			case unicode.IsSpace(character) && !quoted:
+				space = true
+			default:
+				write(character)
+			}
+		}
+		return nil
+	}
+
+	var start int
+	for _, tag := range tags {
+		if err := decode(text[start:tag[0]]); err != nil {
+			return Empty, err
+		}
+		for _, character := range text[tag[0]:tag[1]] {
+			write(character)
+		}
+		start = tag[1]
+	}
+	if err := decode(text[start:]); err != nil {
+		return Empty, err
+	}
+	return builder.String(), nil
+}
+
+// EscapeAndroid encode text as content of an Android string resource,
+// text is quoted if it has leading, trailing or consecutive whitespace.
+// Balanced tags of markup such as `<b>bold</b>`, which are kept by "ParseAndroid", are written as markup, other "<" are escaped.
+func EscapeAndroid(text string) string {
+	var (
+		builder strings.Builder
+		start   int
+	)
+	for _, tag := range markup(text) {
+		builder.WriteString(escapeAndroidText(text[start:tag[0]], start == 0))
+		builder.WriteString(text[tag[0]:tag[1]])
+		start = tag[1]
+	}
+	builder.WriteString(escapeAndroidText(text[start:], start == 0))
+	if strings.TrimSpace(text) != text || strings.Contains(text, "  ") || strings.ContainsAny(text, "\r\f\v") {
+		return `"` + builder.String() + `"`
+	}
+	return builder.String()
+}
+
+// escapeAndroidText encode text without markup as content of an Android string resource,
+// leading "@" and "?" are escaped if text is at beginning of content
+func escapeAndroidText(text string, beginning bool) string {
+	var builder strings.Builder
+	for index, character := range text {
+		switch character {
+		case '\\', '"', '\'':
+			builder.WriteByte(EscapeByte)
+			builder.WriteRune(character)
+		case '@', '?':
+			if index == 0 && beginning {
+				builder.WriteByte(EscapeByte)
+			}
+			builder.WriteRune(character)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+	return EscapeXML(builder.String())
+}
+
+// androidTag the pattern of start, end and empty tags of markup, such as `<b>`, `</b>`, `<br/>` and `<a href="...">`
+var androidTag *regexp.Regexp = regexp.MustCompile(`</?[A-Za-z_][\w.:-]*(?:\s+[A-Za-z_][\w.:-]*="[^"<]*")*\s*/?>`)
+
+// markup return ranges of tags of markup in text in order, a start tag is markup only if it is closed by its end tag
+// and an end tag only if it close a start tag, so unbalanced tags like "<tag>" are text
+func markup(text string) [][]int {
+	var (
+		tags  [][]int = androidTag.FindAllStringIndex(text, -1)
+		kept  []bool  = make([]bool, len(tags))
+		opens []int   // Indexes of start tags which are not closed yet
+	)
+	var name = func(tag []int) string {
+		var element = strings.TrimLeft(text[tag[0]:tag[1]], "</")
+		return element[:strings.IndexFunc(element, func(character rune) bool {
+			return unicode.IsSpace(character) || character == '/' || character == '>'
+		})]
+	}
+	for index, tag := range tags {
+		switch {
+		case strings.HasSuffix(text[tag[0]:tag[1]], "/>"):
+			kept[index] = true
+		case strings.HasPrefix(text[tag[0]:tag[1]], "</"):
+			// Start tags inside the closed element which are not closed are text
+			for open := len(opens) - 1; open >= 0; open-- {
+				if name(tags[opens[open]]) == name(tag) {
+					kept[opens[open]], kept[index] = true, true
+					opens = opens[:open]
+					break
+				}
+			}
+		default:
+			opens = append(opens, index)
+		}
+	}
+
+	var ranges [][]int
+	for index, tag := range tags {
+		if kept[index] {
+			ranges = append(ranges, tag)
+		}
+	}
+	return ranges
+}
@@ -0,0 +1,98 @@
+package internal_test
+
+import (
+	"errors"
+	"html"
+	"testing"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestUnescapeAndroid(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr error
+	}{
+		{"plain", "Hello", "Hello", nil},
+		{"collapse whitespace", "  a \n\t b  ", "a b", nil},
+		{"quoted", `"  a  "b`, "  a  b", nil},
+		{"escapes", `\'\"\\\@\?`, `'"\@?`, nil},
+		{"new line and tab", `a\nb\tc`, "a\nb\tc", nil},
+		{"unicode", `سلام`, "سلام", nil},
+		{"space before escape", `a \n`, "a \n", nil},
+		{"invalid unicode", `\u06`, "", core.ErrEscapeInvalid},
+		{"trailing backslash", `a\`, "", core.ErrEscapeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnescapeAndroid(tt.text)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UnescapeAndroid() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnescapeAndroid() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeAndroid(t *testing.T) {
+	for _, text := range []string{"Hello", `Don't "quote" \ me`, "@string/title", "?attr", "a\nb\tc", "  padded  ", "a  b", "<b> & </b>"} {
+		got, err := UnescapeAndroid(html.UnescapeString(EscapeAndroid(text)))
+		if err != nil || got != text {
+			t.Errorf("UnescapeAndroid(EscapeAndroid(%q)) = %q, %v, want %q", text, got, err, text)
+		}
+	}
+}
+
+func TestEscapeAndroid_Markup(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"<b>bold</b>", "<b>bold</b>"},
+		{`<a href="x">Don't</a>`, `<a href="x">Don\'t</a>`},
+		{"a <br/> b", "a <br/> b"},
+		{"a < b", "a &lt; b"},
+		{"<tag>", "&lt;tag&gt;"},
+		{"</b><b>", "&lt;/b&gt;&lt;b&gt;"},
+		{"<b><i>x</b></i>", "<b>&lt;i&gt;x</b>&lt;/i&gt;"},
+		{"@<b>x</b>", "\\@<b>x</b>"},
+		{"<b>@x</b>", "<b>@x</b>"},
+	}
+	for _, tt := range tests {
+		if got := EscapeAndroid(tt.text); got != tt.want {
+			t.Errorf("EscapeAndroid(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestSplitFormName(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantID    string
+		wantForm  string
+		wantFound bool
+	}{
+		{"songs[one]", "songs", "one", true},
+		{"planets[0]", "planets", "0", true},
+		{"a[b][c]", "a[b]", "c", true},
+		{"songs", "", "", false},
+		{"[one]", "", "", false},
+		{"songs[]", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, form, found := SplitFormName(tt.name)
+			if id != tt.wantID || form != tt.wantForm || found != tt.wantFound {
+				t.Errorf("SplitFormName() = %q, %q, %v, want %q, %q, %v", id, form, found, tt.wantID, tt.wantForm, tt.wantFound)
+			}
+		})
+	}
+}
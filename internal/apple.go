@@ -0,0 +1,329 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined tokens of Apple strings and stringsdict resources
+const (
+	stringsAssign       byte   = '='
+	stringsEnd          byte   = ';'
+	stringsLineComment  string = "//"
+	stringsBlockComment string = "/*"
+	stringsBlockEnd     string = "*/"
+	stringsSymbols      string = "_$+/:.-"
+	plistRoot           string = "plist"
+	plistDict           string = "dict"
+	plistKey            string = "key"
+	plistFormatKey      string = "NSStringLocalizedFormatKey"
+	plistSpecTypeKey    string = "NSStringFormatSpecTypeKey"
+	plistPluralRule     string = "NSStringPluralRuleType"
+)
+
+//┌ Strings
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseApple read records of Apple strings or stringsdict data, a property list of stringsdict is detected by content
+func ParseApple(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	if bytes.HasPrefix(bytes.TrimLeftFunc(data, unicode.IsSpace), []byte("<")) {
+		return ParseStringsdict(data, source)
+	}
+	return ParseStrings(data, source)
+}
+
+// stringsLexer the state of reading tokens of Apple strings data
+type stringsLexer struct {
+	data  []byte
+	index int
+}
+
+// ParseStrings read records of Apple strings data like `"title" = "MyApp";`, with comments `/* */` and `//`.
+// Escape sequences of quoted strings such as "\n" and "\UXXXX" are decoded, data of UTF-16 with byte order mark is decoded.
+func ParseStrings(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var (
+		lexer   stringsLexer        = stringsLexer{data: data}
+		names   map[string]Position = make(map[string]Position)
+		records []Record
+	)
+	var fault = func(offset int, err error) error {
+		var record = RecordAt(data, source, int64(offset))
+		return &core.ParseError{Source: source, Line: record.Line, Column: record.Column, Text: record.Text, Err: err}
+	}
+
+	for {
+		if err := lexer.space(); err != nil {
+			return nil, fault(lexer.index, err)
+		}
+		if lexer.index == len(data) {
+			break
+		}
+
+		var record = RecordAt(data, source, int64(lexer.index))
+		var err error
+		if record.Key, err = lexer.string(); err != nil {
+			return nil, fault(lexer.index, err)
+		}
+		if err := lexer.expect(stringsAssign); err != nil {
+			return nil, fault(lexer.index, err)
+		}
+		if record.Value, err = lexer.string(); err != nil {
+			return nil, fault(lexer.index, err)
+		}
+		if err := lexer.expect(stringsEnd); err != nil {
+			return nil, fault(lexer.index, err)
+		}
+
+		if previous, found := names[record.Key]; found {
+			return nil, Duplication(record, previous)
+		}
+		names[record.Key] = record.Position
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// space skip whitespace and comments, return error if a comment is not closed
+func (l *stringsLexer) space() error {
+	for l.index < len(l.data) {
+		var rest = l.data[l.index:]
+		switch {
+		case unicode.IsSpace(rune(rest[0])):
+			l.index++
+		case bytes.HasPrefix(rest, []byte(stringsLineComment)):
+			end := bytes.IndexByte(rest, NewLineByte)
+			if end < 0 {
+				end = len(rest)
+			}
+			l.index += end
+		case bytes.HasPrefix(rest, []byte(stringsBlockComment)):
+			end := bytes.Index(rest[len(stringsBlockComment):], []byte(stringsBlockEnd))
+			if end < 0 {
+				return core.ErrBlockNotClosed
+			}
+			l.index += len(stringsBlockComment) + end + len(stringsBlockEnd)
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// expect skip whitespace and comments, then read the delimiter
+func (l *stringsLexer) expect(delimiter byte) error {
+	if err := l.space(); err != nil {
+		return err
+	}
+	if l.index == len(l.data) || l.data[l.index] != delimiter {
+		return core.ErrStringsInvalid
+	}
+	l.index++
+	return l.space()
+}
+
+// string read a quoted string, or an unquoted string of letters, digits and symbols "_$+/:.-"
+func (l *stringsLexer) string() (string, error) {
+	var start = l.index
+	if l.index < len(l.data) && l.data[l.index] == QuoteByte {
+		for l.index++; l.index < len(l.data) && l.data[l.index] != QuoteByte; l.index++ {
+			if l.data[l.index] == EscapeByte {
+				l.index++
+			}
+		}
+		if l.index >= len(l.data) {
+			l.index = start
+			return Empty, core.ErrQuoteInvalid
+		}
+		l.index++
+		return unescapeStrings(string(l.data[start+1 : l.index-1]))
+	}
+
+	for l.index < len(l.data) && (isAlphanumeric(rune(l.data[l.index])) || strings.IndexByte(stringsSymbols, l.data[l.index]) >= 0) {
+		l.index++
+	}
+	if l.index == start {
+		return Empty, core.ErrStringsInvalid
+	}
+	return string(l.data[start:l.index]), nil
+}
+
+// isAlphanumeric return `true` if character is an ASCII letter or digit
+func isAlphanumeric(character rune) bool {
+	return character < unicode.MaxASCII && (unicode.IsLetter(character) || unicode.IsDigit(character))
+}
+
+// unescapeStrings decode escape sequences of Apple strings, same as Java properties but "\UXXXX" is also decoded
+func unescapeStrings(text string) (string, error) {
+	if strings.IndexByte(text, EscapeByte) < 0 {
+		return text, nil
+	}
+	var builder strings.Builder
+	builder.Grow(len(text))
+	for index := 0; index < len(text); index++ {
+		builder.WriteByte(text[index])
+		if text[index] == EscapeByte && index+1 < len(text) {
+			index++
+			if text[index] == 'U' {
+				builder.WriteByte('u')
+			} else {
+				builder.WriteByte(text[index])
+			}
+		}
+	}
+	return unescapeProperty(builder.String())
+}
+
+// EscapeStrings encode text as a quoted string of Apple strings
+func EscapeStrings(text string) string {
+	var builder strings.Builder
+	builder.WriteByte(QuoteByte)
+	for _, character := range text {
+		switch character {
+		case '"', '\\':
+			builder.WriteByte(EscapeByte)
+			builder.WriteRune(character)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\r':
+			builder.WriteString(`\r`)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+	builder.WriteByte(QuoteByte)
+	return builder.String()
+}
+
+//┌ Stringsdict
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// plistValue a value of property list, members of dictionaries are in order of source
+type plistValue struct {
+	offset  int64
+	text    string
+	keys    []string
+	members []plistValue
+	dict    bool
+}
+
+// member return value of member of dictionary by key, and `true` if found
+func (v plistValue) member(key string) (plistValue, bool) {
+	for index, name := range v.keys {
+		if name == key {
+			return v.members[index], true
+		}
+	}
+	return plistValue{}, false
+}
+
+// ParseStringsdict read records of Apple stringsdict property list of plural rules.
+// The format of each entry is named by its key, and each plural form by key and quantity like "%d files[one]".
+// For entries of several variables, the form is named by variable and quantity like "%d files[count.one]".
+func ParseStringsdict(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var reader = newXMLReader(data, source)
+	if _, err := reader.root(plistRoot); err != nil {
+		return nil, err
+	}
+
+	var root plistValue
+	err := reader.children(func(element xml.StartElement) error {
+		if root.dict || element.Name.Local != plistDict {
+			return reader.fault(core.ErrXMLInvalid)
+		}
+		var err error
+		root, err = reader.plist(element)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for index, key := range root.keys {
+		var entry = root.members[index]
+		if !entry.dict {
+			reader.offset = entry.offset
+			return nil, reader.fault(core.ErrXMLInvalid)
+		}
+		if format, found := entry.member(plistFormatKey); found {
+			reader.offset = format.offset
+			if err := reader.add(reader.record(key, format.text)); err != nil {
+				return nil, err
+			}
+		}
+
+		var variables []int
+		for member, value := range entry.members {
+			if rule, found := value.member(plistSpecTypeKey); found && rule.text == plistPluralRule {
+				variables = append(variables, member)
+			}
+		}
+		for _, member := range variables {
+			var variable = entry.members[member]
+			for form, quantity := range variable.keys {
+				if !IsQuantity(quantity) {
+					continue
+				}
+				if len(variables) > 1 {
+					quantity = entry.keys[member] + SectionDelimiter + quantity
+				}
+				reader.offset = variable.members[form].offset
+				if err := reader.add(reader.record(FormName(key, quantity), variable.members[form].text)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return reader.records, nil
+}
+
+// plist read a value of property list of element, members of dictionaries are read recursively
+func (r *xmlReader) plist(element xml.StartElement) (plistValue, error) {
+	var value = plistValue{offset: r.offset}
+	switch element.Name.Local {
+	case plistDict:
+		value.dict = true
+		var key *string
+		err := r.children(func(child xml.StartElement) error {
+			if child.Name.Local == plistKey && key == nil {
+				text, err := r.text(nil)
+				key = &text
+				return err
+			}
+			if key == nil {
+				return r.fault(core.ErrXMLInvalid)
+			}
+			member, err := r.plist(child)
+			if err != nil {
+				return err
+			}
+			value.keys = append(value.keys, *key)
+			value.members = append(value.members, member)
+			key = nil
+			return nil
+		})
+		if err == nil && key != nil {
+			err = r.fault(core.ErrXMLInvalid)
+		}
+		return value, err
+	case "true", "false":
+		value.text = element.Name.Local
+		return value, r.skip()
+	case "array":
+		return value, r.skip()
+	default:
+		var err error
+		value.text, err = r.text(nil)
+		return value, err
+	}
+}
@@ -6,11 +6,49 @@ import "regexp"
 
 // Pre-defined control characters
 const (
-	NewLine     string = "\n"
-	NewLineByte byte   = '\n'
-	Empty       string = ""
+	NewLine        string = "\n"
+	NewLineByte    byte   = '\n'
+	CarriageReturn string = "\r"
+	LineBreaks     string = "\r\n"
+	Empty          string = ""
 )
 
+// Pre-defined tokens of multi-line values
+const (
+	Continuation  string = "\\"
+	HeredocPrefix string = "<<<"
+)
+
+// Pre-defined tokens of escape sequences and quoted values
+const (
+	EscapeByte    byte = '\\'
+	QuoteByte     byte = '"'
+	EscapeHexSize int  = 4
+)
+
+// Pre-defined tokens of section headers
+const (
+	SectionOpen      string = "["
+	SectionClose     string = "]"
+	SectionDelimiter string = "."
+)
+
+// Pre-defined byte order marks of Unicode encodings
+var (
+	ByteOrderMarkUTF8    []byte = []byte{0xEF, 0xBB, 0xBF}
+	ByteOrderMarkUTF16LE []byte = []byte{0xFF, 0xFE}
+	ByteOrderMarkUTF16BE []byte = []byte{0xFE, 0xFF}
+)
+
+// Pre-defined delimiter of names and suffixes of languages, such as "title_EN"
+const SuffixDelimiter string = "_"
+
+// Pre-defined directive to include another source
+const IncludeDirective string = "@include"
+
+// Maximum size of a line of source in bytes
+const LineMaxSize int = 1 << 30
+
 //┌ Regex
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// IndexUnescaped return index of the first occurrence of sub in text which is not escaped by backslash,
+// else return -1
+func IndexUnescaped(text string, sub string) int {
+	for index := 0; index < len(text); index++ {
+		if text[index] == EscapeByte {
+			index++
+			continue
+		}
+		if strings.HasPrefix(text[index:], sub) {
+			return index
+		}
+	}
+	return -1
+}
+
+// ExtractEscaped search for an escaped name in line which its name is escaped,
+// and return value and true if found, else return empty string and false if not found
+func ExtractEscaped(line string, name string, separator string) (string, bool) {
+	var index = IndexUnescaped(line, separator)
+	if index >= 0 && line[:index] == name {
+		return line[index+len(separator):], true
+	}
+	return Empty, false
+}
+
+// Escaped check text to end with an escaping backslash, which is an odd number of trailing backslashes
+func Escaped(text string) bool {
+	var count int
+	for index := len(text) - 1; index >= 0 && text[index] == EscapeByte; index-- {
+		count++
+	}
+	return count%2 == 1
+}
+
+// Unescape decode escape sequences of text.
+// Supported sequences are "\n", "\t", "\r", "\uXXXX" and backslash followed by a character which is not letter or digit,
+// such as "\\", "\=" and "\"", which is decoded to the character itself.
+// On error, return byte index of the invalid sequence in text.
+func Unescape(text string) (string, int, error) {
+	if strings.IndexByte(text, EscapeByte) < 0 {
+		return text, 0, nil
+	}
+	var builder strings.Builder
+	builder.Grow(len(text))
+	for index := 0; index < len(text); index++ {
+		if text[index] != EscapeByte {
+			builder.WriteByte(text[index])
+			continue
+		}
+		character, size := utf8.DecodeRuneInString(text[index+1:])
+		switch {
+		case size == 0:
+			return Empty, index, core.ErrEscapeInvalid
+		case character == 'n':
+			builder.WriteByte('\n')
+		case character == 't':
+			builder.WriteByte('\t')
+		case character == 'r':
+			builder.WriteByte('\r')
+		case character == 'u':
+			var hex = text[index+2:]
+			if len(hex) < EscapeHexSize {
+				return Empty, index, core.ErrEscapeInvalid
+			}
+			code, err := strconv.ParseUint(hex[:EscapeHexSize], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(code)) {
+				return Empty, index, core.ErrEscapeInvalid
+			}
+			builder.WriteRune(rune(code))
+			size += EscapeHexSize
+		case unicode.IsLetter(character) || unicode.IsDigit(character):
+			return Empty, index, core.ErrEscapeInvalid
+		default:
+			builder.WriteRune(character)
+		}
+		index += size
+	}
+	return builder.String(), 0, nil
+}
+
+// Unquote decode value enclosed in double quotes or a plain value, both with escape sequences.
+// A quoted value keeps its leading and trailing whitespace.
+// On error, return byte index of the problem in value.
+func Unquote(value string) (string, int, error) {
+	if value == Empty || value[0] != QuoteByte {
+		return Unescape(value)
+	}
+	var closing = IndexUnescaped(value[1:], string(QuoteByte)) + 1
+	if closing == 0 || closing != len(value)-1 {
+		return Empty, 0, core.ErrQuoteInvalid
+	}
+	text, index, err := Unescape(value[1:closing])
+	if err != nil {
+		return Empty, index + 1, err
+	}
+	return text, 0, nil
+}
+
+// EscapeText encode text by escape sequences to be decoded by "Unescape",
+// backslash, control characters and characters of specials are escaped
+func EscapeText(text string, specials string) string {
+	var builder strings.Builder
+	builder.Grow(len(text))
+	for _, character := range text {
+		switch {
+		case character == '\n':
+			builder.WriteString(`\n`)
+		case character == '\t':
+			builder.WriteString(`\t`)
+		case character == '\r':
+			builder.WriteString(`\r`)
+		case unicode.IsControl(character):
+			builder.WriteString(`\u`)
+			hex := strconv.FormatUint(uint64(character), 16)
+			builder.WriteString(strings.Repeat("0", EscapeHexSize-len(hex)))
+			builder.WriteString(hex)
+		case character == rune(EscapeByte) || strings.ContainsRune(specials, character):
+			builder.WriteByte(EscapeByte)
+			builder.WriteRune(character)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+	return builder.String()
+}
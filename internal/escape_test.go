@@ -0,0 +1,181 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestIndexUnescaped(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		sub  string
+		want int
+	}{
+		{"plain", "k1=v1", "=", 2},
+		{"escaped", `k\=1=v1`, "=", 4},
+		{"escaped backslash", `k\\=v1`, "=", 3},
+		{"only escaped", `k\=1`, "=", -1},
+		{"absent", "k1", "=", -1},
+		{"empty", "", "=", -1},
+		{"quote", `"a\"b"`, `"`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexUnescaped(tt.text, tt.sub); got != tt.want {
+				t.Errorf("IndexUnescaped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractEscaped(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		key       string
+		wantValue string
+		wantFound bool
+	}{
+		{"plain", "k1=v1", "k1", "v1", true},
+		{"escaped separator", `a\=b=v=2`, `a\=b`, "v=2", true},
+		{"escaped separator partial", `a\=b=v2`, "a", "", false},
+		{"escaped backslash", `a\\=v3`, `a\\`, "v3", true},
+		{"absent", "k1=v1", "k2", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotFound := ExtractEscaped(tt.line, tt.key, "=")
+			if gotValue != tt.wantValue || gotFound != tt.wantFound {
+				t.Errorf("ExtractEscaped() = %q, %v, want %q, %v", gotValue, gotFound, tt.wantValue, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestEscaped(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"one", `a\`, true},
+		{"two", `a\\`, false},
+		{"three", `a\\\`, true},
+		{"none", "a", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Escaped(tt.text); got != tt.want {
+				t.Errorf("Escaped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		want      string
+		wantIndex int
+		wantErr   error
+	}{
+		{"plain", "value", "value", 0, nil},
+		{"line break", `a\nb`, "a\nb", 0, nil},
+		{"tab", `a\tb`, "a\tb", 0, nil},
+		{"carriage return", `a\rb`, "a\rb", 0, nil},
+		{"backslash", `a\\b`, `a\b`, 0, nil},
+		{"separator", `a\=b`, "a=b", 0, nil},
+		{"quote", `\"a\"`, `"a"`, 0, nil},
+		{"space", `\ a`, " a", 0, nil},
+		{"unicode", `متن`, "متن", 0, nil},
+		{"unicode upper", `é`, "é", 0, nil},
+		{"escaped non ascii", `\«`, "«", 0, nil},
+		{"escaped letter", `\ک`, "", 0, core.ErrEscapeInvalid},
+		{"dangling", `ab\`, "", 2, core.ErrEscapeInvalid},
+		{"unknown letter", `a\qb`, "", 1, core.ErrEscapeInvalid},
+		{"digit", `a\0`, "", 1, core.ErrEscapeInvalid},
+		{"short unicode", `ab\u12`, "", 2, core.ErrEscapeInvalid},
+		{"invalid unicode", `\u12G4`, "", 0, core.ErrEscapeInvalid},
+		{"surrogate", `\uD800`, "", 0, core.ErrEscapeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotIndex, err := Unescape(tt.text)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unescape() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want || gotIndex != tt.wantIndex {
+				t.Errorf("Unescape() = %q, %v, want %q, %v", got, gotIndex, tt.want, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		want      string
+		wantIndex int
+		wantErr   error
+	}{
+		{"plain", `a\tb`, "a\tb", 0, nil},
+		{"quoted", `"  padded  "`, "  padded  ", 0, nil},
+		{"quoted escapes", `"a\"b\n"`, "a\"b\n", 0, nil},
+		{"quoted empty", `""`, "", 0, nil},
+		{"empty", "", "", 0, nil},
+		{"escaped quote", `\"a"`, `"a"`, 0, nil},
+		{"not closed", `"abc`, "", 0, core.ErrQuoteInvalid},
+		{"escaped closing", `"abc\"`, "", 0, core.ErrQuoteInvalid},
+		{"only quote", `"`, "", 0, core.ErrQuoteInvalid},
+		{"text after closing", `"a" b`, "", 0, core.ErrQuoteInvalid},
+		{"invalid escape", `"ab\q"`, "", 3, core.ErrEscapeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotIndex, err := Unquote(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unquote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want || gotIndex != tt.wantIndex {
+				t.Errorf("Unquote() = %q, %v, want %q, %v", got, gotIndex, tt.want, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		specials string
+		want     string
+	}{
+		{"plain", "value", "=", "value"},
+		{"controls", "a\nb\tc\rd\x00", "", `a\nb\tc\rd\u0000`},
+		{"backslash", `a\b`, "", `a\\b`},
+		{"specials", `a=b"c`, `="`, `a\=b\"c`},
+		{"unicode", "متن", "", "متن"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EscapeText(tt.text, tt.specials)
+			if got != tt.want {
+				t.Errorf("EscapeText() = %q, want %q", got, tt.want)
+			}
+			decoded, _, err := Unescape(got)
+			if err != nil || decoded != tt.text {
+				t.Errorf("Unescape(EscapeText()) = %q, %v, want %q", decoded, err, tt.text)
+			}
+		})
+	}
+}
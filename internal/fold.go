@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Fold fold name by rules, case is folded by Unicode simple case folding to lower case
+// and whitespace inside name is collapsed to one space
+func Fold(name string, fold core.Fold) string {
+	if fold&core.FoldSpace != 0 {
+		name = strings.Join(strings.Fields(name), " ")
+	}
+	if fold&core.FoldCase != 0 {
+		name = strings.Map(foldRune, name)
+	}
+	return name
+}
+
+// Folding return a function to normalize names by normalize then fold them by rules,
+// return normalize if there is no rule
+func Folding(normalize func(string) string, fold core.Fold) func(string) string {
+	if fold&(core.FoldCase|core.FoldSpace) == 0 {
+		return normalize
+	}
+	return func(name string) string {
+		if normalize != nil {
+			name = normalize(name)
+		}
+		return Fold(name, fold)
+	}
+}
+
+// foldRune return the lower case of the smallest character which is equivalent to character under simple case folding,
+// so all equivalent characters such as "K", "k" and Kelvin sign are folded to the same character
+func foldRune(character rune) rune {
+	var smallest = character
+	for folded := unicode.SimpleFold(character); folded != character; folded = unicode.SimpleFold(folded) {
+		if folded < smallest {
+			smallest = folded
+		}
+	}
+	return unicode.ToLower(smallest)
+}
@@ -0,0 +1,50 @@
+package internal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		fold  core.Fold
+		want  string
+	}{
+		{"no rule", "App  Name", 0, "App  Name"},
+		{"case", "App  Name", core.FoldCase, "app  name"},
+		{"space", "App \t Name", core.FoldSpace, "App Name"},
+		{"case and space", "App   NAME", core.FoldCase | core.FoldSpace, "app name"},
+		{"kelvin sign", "\u212Aelvin", core.FoldCase, "kelvin"},
+		{"long s", "Claſs", core.FoldCase, "class"},
+		{"final sigma", "ΟΔΟΣ οδος", core.FoldCase, "οδοσ οδοσ"},
+		{"persian", "سلام  دنیا", core.FoldCase | core.FoldSpace, "سلام دنیا"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fold(tt.input, tt.fold); got != tt.want {
+				t.Errorf("Fold() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFolding(t *testing.T) {
+	if got := Folding(nil, 0); got != nil {
+		t.Errorf("Folding() = %p, want nil", got)
+	}
+	normalize := Folding(func(name string) string { return strings.TrimPrefix(name, "app.") }, core.FoldCase)
+	if got := normalize("app.Title"); got != "title" {
+		t.Errorf("Folding()() = %q, want %q", got, "title")
+	}
+	if got := Folding(nil, core.FoldSpace)("a   b"); got != "a b" {
+		t.Errorf("Folding()() = %q, want %q", got, "a b")
+	}
+}
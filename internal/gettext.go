@@ -0,0 +1,431 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined tokens of gettext catalogs
+const (
+	poContext     string = "msgctxt"
+	poID          string = "msgid"
+	poPlural      string = "msgid_plural"
+	poTranslation string = "msgstr"
+	poFlags       string = "#,"
+	poFuzzy       string = "fuzzy"
+	moMagic       uint32 = 0x950412de
+	moHeaderSize  int    = 28
+)
+
+// PluralForm the form of name of plural source text of msgid_plural, such as "apple[plural]"
+const PluralForm string = "plural"
+
+// PluralName return name of plural form of id, such as "apple[1]"
+func PluralName(id string, form int) string {
+	return FormName(id, strconv.Itoa(form))
+}
+
+// ContextName return name of id in context, joined by "core.ContextSeparator"
+func ContextName(context string, id string) string {
+	return context + core.ContextSeparator + id
+}
+
+//┌ PO
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// poEntry an entry of PO catalog which is being read
+type poEntry struct {
+	position     Position
+	column       int
+	text         string
+	context      *string
+	id           *string
+	plural       *string
+	translations map[int]string
+	forms        []int
+	fuzzy        bool
+	last         func(string) // Append following string lines to the last keyword
+}
+
+// ParsePO read records of a gettext PO catalog. The name is msgid, or msgctxt and msgid joined by "core.ContextSeparator".
+// Plural forms are named by "PluralName" like "msgid[1]", and the first form is also named by msgid.
+// The plural source text of msgid_plural is named by "PluralForm" like "msgid[plural]", to write back plural entries.
+// The header, fuzzy and untranslated entries are skipped.
+func ParsePO(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var (
+		scanner *bufio.Scanner      = bufio.NewScanner(bytes.NewReader(data))
+		next    Position            = Position{Source: source, Line: 1}
+		names   map[string]Position = make(map[string]Position)
+		records []Record
+		entry   *poEntry = &poEntry{}
+		fuzzy   bool
+	)
+	scanner.Buffer(nil, LineMaxSize)
+	scanner.Split(ScanLines)
+
+	var flush = func() error {
+		defer func() { entry = &poEntry{} }()
+		if entry.id == nil {
+			if entry.context != nil || len(entry.forms) > 0 {
+				return &core.ParseError{Source: source, Line: entry.position.Line, Column: entry.column, Text: entry.text, Err: core.ErrNameNotPresent}
+			}
+			return nil
+		}
+		for _, record := range entry.records() {
+			if previous, found := names[record.Key]; found {
+				return Duplication(record, previous)
+			}
+			names[record.Key] = record.Position
+			records = append(records, record)
+		}
+		return nil
+	}
+
+	for scanner.Scan() {
+		var (
+			text     string   = TrimLineBreak(scanner.Text())
+			position Position = next
+			data     string   = strings.TrimSpace(text)
+			column   int      = Column(text, len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace)))
+		)
+		next.Line++
+		next.Offset += int64(len(scanner.Bytes()))
+
+		var fault = func(err error) error {
+			return &core.ParseError{Source: source, Line: position.Line, Column: column, Text: text, Err: err}
+		}
+
+		switch {
+		case data == Empty:
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			fuzzy = false
+			continue
+		case strings.HasPrefix(data, poFlags):
+			for _, flag := range strings.Split(data[len(poFlags):], ",") {
+				if strings.TrimSpace(flag) == poFuzzy {
+					fuzzy = true
+				}
+			}
+			continue
+		case strings.HasPrefix(data, "#"):
+			continue
+		case data[0] == QuoteByte:
+			if entry.last == nil {
+				return nil, fault(core.ErrPOInvalid)
+			}
+			value, err := UnquotePO(data)
+			if err != nil {
+				return nil, fault(err)
+			}
+			entry.last(value)
+			continue
+		}
+
+		var keyword, quoted = data, Empty
+		if index := strings.IndexFunc(data, unicode.IsSpace); index >= 0 {
+			keyword, quoted = data[:index], strings.TrimSpace(data[index:])
+		}
+		value, err := UnquotePO(quoted)
+		if err != nil {
+			return nil, fault(err)
+		}
+
+		// An entry starts with msgctxt or msgid, the previous entry ends when it has translations
+		if (keyword == poContext || keyword == poID) && len(entry.forms) > 0 {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		if entry.id == nil && entry.context == nil {
+			entry.position, entry.column, entry.text, entry.fuzzy = position, column, text, fuzzy
+			fuzzy = false
+		}
+
+		if err := entry.set(keyword, value); err != nil {
+			return nil, fault(err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// set set string of keyword in entry, return error if keyword is unknown or not in order
+func (e *poEntry) set(keyword string, value string) error {
+	var field *string
+	switch {
+	case keyword == poContext && e.context == nil && e.id == nil:
+		e.context = &value
+		field = e.context
+	case keyword == poID && e.id == nil:
+		e.id = &value
+		field = e.id
+	case keyword == poPlural && e.id != nil && e.plural == nil && len(e.forms) == 0:
+		e.plural = &value
+		field = e.plural
+	case keyword == poTranslation && e.id != nil && e.plural == nil && len(e.forms) == 0:
+		return e.translate(0, value)
+	case strings.HasPrefix(keyword, poTranslation+"[") && strings.HasSuffix(keyword, "]") && e.plural != nil:
+		form, err := strconv.Atoi(keyword[len(poTranslation)+1 : len(keyword)-1])
+		if err != nil || form < 0 {
+			return core.ErrPOInvalid
+		}
+		return e.translate(form, value)
+	default:
+		return core.ErrPOInvalid
+	}
+	e.last = func(text string) { *field += text }
+	return nil
+}
+
+// translate set translation of plural form in entry
+func (e *poEntry) translate(form int, value string) error {
+	if e.translations == nil {
+		e.translations = make(map[int]string)
+	}
+	if _, found := e.translations[form]; found {
+		return core.ErrPOInvalid
+	}
+	e.translations[form] = value
+	e.forms = append(e.forms, form)
+	e.last = func(text string) { e.translations[form] += text }
+	return nil
+}
+
+// records return records of entry, the header, fuzzy and untranslated entries have no records
+func (e *poEntry) records() []Record {
+	if e.fuzzy || (*e.id == Empty && e.context == nil) {
+		return nil
+	}
+	var name = *e.id
+	if e.context != nil {
+		name = ContextName(*e.context, name)
+	}
+	var records []Record
+	var add = func(key string, value string) {
+		if value != Empty {
+			records = append(records, Record{Position: e.position, Key: key, Value: value, Column: e.column, Text: e.text})
+		}
+	}
+	for _, form := range e.forms {
+		var translation = e.translations[form]
+		if form == 0 {
+			add(name, translation)
+		}
+		if e.plural != nil {
+			add(PluralName(name, form), translation)
+		}
+	}
+	if e.plural != nil && len(records) > 0 {
+		add(FormName(name, PluralForm), *e.plural)
+	}
+	return records
+}
+
+// UnquotePO decode a C string of PO catalog enclosed in double quotes by escape sequences of C:
+// "\a", "\b", "\f", "\n", "\r", "\t", "\v", "\\", "\"", "\'", "\?", octal "\ooo" of 1 to 3 digits and hexadecimal "\xhh",
+// octal and hexadecimal sequences are decoded to bytes
+func UnquotePO(text string) (string, error) {
+	if len(text) < 2 || text[0] != QuoteByte || text[len(text)-1] != QuoteByte {
+		return Empty, core.ErrQuoteInvalid
+	}
+	text = text[1 : len(text)-1]
+	if strings.IndexByte(text, EscapeByte) < 0 {
+		if strings.IndexByte(text, QuoteByte) >= 0 {
+			return Empty, core.ErrQuoteInvalid
+		}
+		return text, nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(text))
+	for index := 0; index < len(text); index++ {
+		switch text[index] {
+		case QuoteByte:
+			return Empty, core.ErrQuoteInvalid
+		case EscapeByte:
+		default:
+			builder.WriteByte(text[index])
+			continue
+		}
+		index++
+		if index == len(text) {
+			// The closing quote is escaped
+			return Empty, core.ErrQuoteInvalid
+		}
+		if character, found := poEscapes[text[index]]; found {
+			builder.WriteByte(character)
+			continue
+		}
+		var (
+			base   int = 8
+			digits int = 3
+			start  int = index
+		)
+		if text[index] == 'x' {
+			base, digits, start = 16, 2, index+1
+		}
+		var end = start
+		for end < len(text) && end-start < digits && isDigit(text[end], base) {
+			end++
+		}
+		if end == start {
+			return Empty, core.ErrEscapeInvalid
+		}
+		code, err := strconv.ParseUint(text[start:end], base, 8)
+		if err != nil {
+			return Empty, core.ErrEscapeInvalid
+		}
+		builder.WriteByte(byte(code))
+		index = end - 1
+	}
+	return builder.String(), nil
+}
+
+// poEscapes characters of escape sequences of C strings, by the character after backslash
+var poEscapes = map[byte]byte{
+	'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+	'\\': '\\', '"': '"', '\'': '\'', '?': '?',
+}
+
+// isDigit return `true` if character is a digit of base 8 or 16
+func isDigit(character byte, base int) bool {
+	switch {
+	case character >= '0' && character <= '7':
+		return true
+	case base == 16:
+		return character >= '8' && character <= '9' || character >= 'a' && character <= 'f' || character >= 'A' && character <= 'F'
+	}
+	return false
+}
+
+// QuotePO encode text as a string of PO catalog enclosed in double quotes
+func QuotePO(text string) string {
+	var builder strings.Builder
+	builder.WriteByte(QuoteByte)
+	for _, character := range text {
+		switch character {
+		case '"':
+			builder.WriteString(`\"`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\r':
+			builder.WriteString(`\r`)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+	builder.WriteByte(QuoteByte)
+	return builder.String()
+}
+
+//┌ MO
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseMO read records of a compiled gettext MO catalog, names are same as "ParsePO".
+// Line of records is number of entry in catalog, starting from 1.
+func ParseMO(data []byte, source string) ([]Record, error) {
+	if len(data) < moHeaderSize {
+		return nil, core.ErrMOInvalid
+	}
+
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(data) == moMagic:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(data) == moMagic:
+		order = binary.BigEndian
+	default:
+		return nil, core.ErrMOInvalid
+	}
+
+	var (
+		count        uint32 = order.Uint32(data[8:])
+		originals    uint32 = order.Uint32(data[12:])
+		translations uint32 = order.Uint32(data[16:])
+	)
+	var text = func(table uint32, index uint32) (string, bool) {
+		var offset = uint64(table) + uint64(index)*8
+		if offset+8 > uint64(len(data)) {
+			return Empty, false
+		}
+		var (
+			length uint64 = uint64(order.Uint32(data[offset:]))
+			start  uint64 = uint64(order.Uint32(data[offset+4:]))
+		)
+		if start+length > uint64(len(data)) {
+			return Empty, false
+		}
+		return string(data[start : start+length]), true
+	}
+
+	var (
+		names   map[string]Position = make(map[string]Position)
+		records []Record
+	)
+	for index := uint32(0); index < count; index++ {
+		original, ok := text(originals, index)
+		if !ok {
+			return nil, core.ErrMOInvalid
+		}
+		translation, ok := text(translations, index)
+		if !ok {
+			return nil, core.ErrMOInvalid
+		}
+		if original == Empty {
+			// Header of catalog
+			continue
+		}
+
+		var (
+			ids      []string = strings.Split(original, "\x00")
+			forms    []string = strings.Split(translation, "\x00")
+			position Position = Position{Source: source, Line: int(index) + 1}
+			add               = func(key string, value string) error {
+				if value == Empty {
+					return nil
+				}
+				var record = Record{Position: position, Key: key, Value: value, Column: 1}
+				if previous, found := names[key]; found {
+					return Duplication(record, previous)
+				}
+				names[key] = position
+				records = append(records, record)
+				return nil
+			}
+		)
+		if err := add(ids[0], forms[0]); err != nil {
+			return nil, err
+		}
+		if len(ids) > 1 {
+			for form, value := range forms {
+				if err := add(PluralName(ids[0], form), value); err != nil {
+					return nil, err
+				}
+			}
+			if err := add(FormName(ids[0], PluralForm), ids[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestUnquotePO(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", `"Hello"`, "Hello"},
+		{"empty", `""`, Empty},
+		{"unicode", `"سلام"`, "سلام"},
+		{"escapes", `"\a\b\f\n\r\t\v"`, "\a\b\f\n\r\t\v"},
+		{"escaped quotes", `"\"a\" \'b\' \\"`, `"a" 'b' \`},
+		{"question mark", `"what\?"`, "what?"},
+		{"octal", `"\101\0x\12"`, "A\x00x\n"},
+		{"octal of three digits", `"\1011"`, "A1"},
+		{"octal bytes", `"\330\263"`, "س"},
+		{"hexadecimal", `"\x41\x4a\x4Bz"`, "AJKz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnquotePO(tt.text)
+			if err != nil {
+				t.Fatalf("UnquotePO() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UnquotePO() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnquotePO_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want error
+	}{
+		{"not quoted", `Hello`, core.ErrQuoteInvalid},
+		{"single quote", `"`, core.ErrQuoteInvalid},
+		{"inner quote", `"a"b"`, core.ErrQuoteInvalid},
+		{"inner quote after escape", `"\n"b"`, core.ErrQuoteInvalid},
+		{"unknown escape", `"\q"`, core.ErrEscapeInvalid},
+		{"trailing backslash", `"a\"`, core.ErrQuoteInvalid},
+		{"hexadecimal without digits", `"\xg"`, core.ErrEscapeInvalid},
+		{"octal out of range", `"\777"`, core.ErrEscapeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := UnquotePO(tt.text); !errors.Is(err, tt.want) {
+				t.Errorf("UnquotePO() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
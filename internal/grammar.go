@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"io/fs"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Grammar the rules to parse source
+type Grammar struct {
+	Separator      string              // Separator of name and value
+	Comments       []string            // Prefixes of comment lines
+	Trim           core.Trim           // Policy of trimming whitespace around values
+	Duplicates     core.Duplicates     // Policy of duplicated names
+	Overrides      func(core.Override) // Function to report duplicated names resolved by policy, nil ignores them
+	Normalize      func(string) string // Function to normalize names in parsing and searching, nil keeps names as is
+	Multiline      bool                // Support continuation lines and heredoc blocks for values
+	Escapes        bool                // Support escape sequences in names and values, and quoted values
+	InlineComments bool                // Support trailing comments after values
+	Sections       bool                // Support section headers which prefix names of following lines
+	Includes       fs.FS               // File system to read sources of include directives, nil disables include directives
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Parse parse the line of words and return "key", "value" if has not error.
+// With escapes, the key is decoded and an escaped separator is part of the key,
+// but the value is raw and must be decoded by "Unquote".
+// Error of an invalid line is "*core.ParseError" without line number and source name.
+func (g Grammar) Parse(line string) (string, string, error) {
+	var data = strings.TrimLeftFunc(line, unicode.IsSpace)
+	if strings.TrimSpace(data) == Empty {
+		return Empty, Empty, core.ErrLineEmpty
+	}
+	if g.comment(data) >= 0 {
+		return Empty, Empty, core.ErrLineComment
+	}
+
+	var start = len(line) - len(data)
+
+	var index = g.index(data)
+	if index < 0 {
+		return Empty, Empty, &core.ParseError{Column: Column(line, start), Text: line, Err: core.ErrSeparatorNotPresent}
+	}
+
+	var key = strings.TrimSpace(data[:index])
+	if key == Empty {
+		return Empty, Empty, &core.ParseError{Column: Column(line, start+index), Text: line, Err: core.ErrNameNotPresent}
+	}
+
+	if g.Escapes {
+		var (
+			position int
+			err      error
+		)
+		key, position, err = Unescape(key)
+		if err != nil {
+			return Empty, Empty, &core.ParseError{Column: Column(line, start+position), Text: line, Err: err}
+		}
+	}
+
+	return key, g.TrimComment(g.trim(data[index+len(g.Separator):])), nil
+}
+
+// trim remove whitespace around value by policy of grammar
+func (g Grammar) trim(value string) string {
+	switch g.Trim {
+	case core.TrimLeading:
+		return strings.TrimLeftFunc(value, unicode.IsSpace)
+	case core.TrimNone:
+		return value
+	default:
+		return strings.TrimSpace(value)
+	}
+}
+
+// comment return length of the comment prefix which text starts with, else return -1
+func (g Grammar) comment(text string) int {
+	for _, prefix := range g.Comments {
+		if strings.HasPrefix(text, prefix) {
+			return len(prefix)
+		}
+	}
+	return -1
+}
+
+// Section check line to be a section header like "[name]" if sections are supported,
+// return trimmed name of section and true, an empty name like "[]" ends the current section
+func (g Grammar) Section(line string) (string, bool) {
+	if !g.Sections {
+		return Empty, false
+	}
+	var data = g.TrimComment(strings.TrimSpace(line))
+	if !strings.HasPrefix(data, SectionOpen) || !strings.HasSuffix(data, SectionClose) {
+		return Empty, false
+	}
+	return strings.TrimSpace(data[len(SectionOpen) : len(data)-len(SectionClose)]), true
+}
+
+// Include check line to be an include directive like "@include path" if include directives are supported,
+// return the path and true
+func (g Grammar) Include(line string) (string, bool) {
+	if g.Includes == nil {
+		return Empty, false
+	}
+	var data = g.TrimComment(strings.TrimSpace(line))
+	if !strings.HasPrefix(data, IncludeDirective) {
+		return Empty, false
+	}
+	var name = strings.TrimPrefix(data, IncludeDirective)
+	if name == Empty || !unicode.IsSpace(rune(name[0])) {
+		return Empty, false
+	}
+	return strings.TrimSpace(name), true
+}
+
+// Escaped check names of records must be escaped to store them in lines of name, separator and value,
+// as names may contain separator by escapes or sections, may be changed by normalizer,
+// or may end with a part of a separator of several characters like "a:" of "::"
+func (g Grammar) Escaped() bool {
+	return g.Escapes || g.Sections || g.Normalize != nil || utf8.RuneCountInString(g.Separator) > 1
+}
+
+// ValueIndex return byte index of value in line, return -1 if line has not separator
+func (g Grammar) ValueIndex(line string) int {
+	var index = g.index(line)
+	if index < 0 {
+		return -1
+	}
+	index += len(g.Separator)
+	return index + len(line[index:]) - len(strings.TrimLeftFunc(line[index:], unicode.IsSpace))
+}
+
+// index return byte index of the first separator in text, with escapes the escaped separators are skipped
+func (g Grammar) index(text string) int {
+	if g.Escapes {
+		return IndexUnescaped(text, g.Separator)
+	}
+	return strings.Index(text, g.Separator)
+}
+
+// TrimComment remove trailing comment of value if inline comments are supported.
+// A comment starts at a comment prefix in beginning of value or after whitespace,
+// with escapes an escaped comment prefix or a comment character in quoted value is not a comment.
+func (g Grammar) TrimComment(value string) string {
+	if !g.InlineComments {
+		return value
+	}
+	var start int
+	if g.Escapes && value != Empty && value[0] == QuoteByte {
+		var closing = IndexUnescaped(value[1:], string(QuoteByte))
+		if closing < 0 {
+			return value
+		}
+		start = closing + 2
+	}
+	for index := start; index < len(value); index++ {
+		if g.Escapes && value[index] == EscapeByte {
+			index++
+			continue
+		}
+		if g.comment(value[index:]) < 0 {
+			continue
+		}
+		if index == 0 || value[index-1] == ' ' || value[index-1] == '\t' {
+			return strings.TrimSpace(value[:index])
+		}
+	}
+	return value
+}
+
+// Heredoc check value to be the opening of a heredoc block like "<<<END", return the closing delimiter and true,
+// else return empty string and false
+func Heredoc(value string) (string, bool) {
+	if !strings.HasPrefix(value, HeredocPrefix) {
+		return Empty, false
+	}
+	var delimiter = strings.TrimPrefix(value, HeredocPrefix)
+	if delimiter == Empty || strings.IndexFunc(delimiter, unicode.IsSpace) >= 0 {
+		return Empty, false
+	}
+	return delimiter, true
+}
@@ -0,0 +1,199 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestGrammar_Parse_Escapes(t *testing.T) {
+	grammar := grammar_DEFAULT
+	grammar.Escapes = true
+	tests := []struct {
+		name       string
+		line       string
+		wantKey    string
+		wantValue  string
+		wantErr    error
+		wantColumn int
+	}{
+		{"plain", "k1 = v1", "k1", "v1", nil, 0},
+		{"escaped separator", `a\=b = v`, "a=b", "v", nil, 0},
+		{"escaped backslash before separator", `a\\ = v`, `a\`, "v", nil, 0},
+		{"escaped comment", `\# k = v`, "# k", "v", nil, 0},
+		{"raw value", `k = "  \t  "`, "k", `"  \t  "`, nil, 0},
+		{"only escaped separator", `  k\=v`, "", "", core.ErrSeparatorNotPresent, 3},
+		{"invalid escape in key", `  ab\q = v`, "", "", core.ErrEscapeInvalid, 5},
+		{"comment", "# k = v", "", "", core.ErrLineComment, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value, err := grammar.Parse(tt.line)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Grammar.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var parseError *core.ParseError
+			if errors.As(err, &parseError) && parseError.Column != tt.wantColumn {
+				t.Errorf("Grammar.Parse() column = %v, want %v", parseError.Column, tt.wantColumn)
+			}
+			if key != tt.wantKey || value != tt.wantValue {
+				t.Errorf("Grammar.Parse() = %q, %q, want %q, %q", key, value, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestGrammar_TrimComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		escapes bool
+		value   string
+		want    string
+	}{
+		{"trailing", false, "30  # seconds", "30"},
+		{"tab", false, "30\t# seconds", "30"},
+		{"only comment", false, "# comment", ""},
+		{"no whitespace", false, "a#b", "a#b"},
+		{"no comment", false, "value", "value"},
+		{"empty", false, "", ""},
+		{"backslash without escapes", false, `a \ # b`, `a \`},
+		{"escaped", true, `a \# b # c`, `a \# b`},
+		{"quoted", true, `"a # b" # c`, `"a # b"`},
+		{"quoted escaped quote", true, `"a \" # b" # c`, `"a \" # b"`},
+		{"quoted not closed", true, `"a # b`, `"a # b`},
+		{"quote without escapes", false, `"a # b"`, `"a`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grammar := grammar_DEFAULT
+			grammar.InlineComments = true
+			grammar.Escapes = tt.escapes
+			if got := grammar.TrimComment(tt.value); got != tt.want {
+				t.Errorf("Grammar.TrimComment() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if got := grammar_DEFAULT.TrimComment("30 # seconds"); got != "30 # seconds" {
+		t.Errorf("Grammar.TrimComment() = %q, want %q", got, "30 # seconds")
+	}
+}
+
+func TestGrammar_Section(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantSection string
+		wantOk      bool
+	}{
+		{"valid", "[errors]", "errors", true},
+		{"sparse", "  [ errors.http ]  ", "errors.http", true},
+		{"unicode", "[خطاها]", "خطاها", true},
+		{"empty", "[]", "", true},
+		{"empty space", "[  ]", "", true},
+		{"inline comment", "[errors] # comment", "errors", true},
+		{"not closed", "[errors", "", false},
+		{"not opened", "errors]", "", false},
+		{"record", "[errors] = v", "", false},
+		{"only open", "[", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grammar := grammar_DEFAULT
+			grammar.Sections = true
+			grammar.InlineComments = true
+			gotSection, gotOk := grammar.Section(tt.line)
+			if gotSection != tt.wantSection || gotOk != tt.wantOk {
+				t.Errorf("Grammar.Section() = %q, %v, want %q, %v", gotSection, gotOk, tt.wantSection, tt.wantOk)
+			}
+		})
+	}
+	if _, ok := grammar_DEFAULT.Section("[errors]"); ok {
+		t.Errorf("Grammar.Section() = %v, want %v", ok, false)
+	}
+}
+
+func TestGrammar_Include(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		wantPath string
+		wantOk   bool
+	}{
+		{"valid", "@include errors", "errors", true},
+		{"sparse", "  @include   modules/errors  ", "modules/errors", true},
+		{"inline comment", "@include errors # comment", "errors", true},
+		{"no path", "@include", "", false},
+		{"no space", "@includeerrors", "", false},
+		{"record", "include = errors", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grammar := grammar_DEFAULT
+			grammar.Includes = fstest.MapFS{}
+			grammar.InlineComments = true
+			gotPath, gotOk := grammar.Include(tt.line)
+			if gotPath != tt.wantPath || gotOk != tt.wantOk {
+				t.Errorf("Grammar.Include() = %q, %v, want %q, %v", gotPath, gotOk, tt.wantPath, tt.wantOk)
+			}
+		})
+	}
+	if _, ok := grammar_DEFAULT.Include("@include errors"); ok {
+		t.Errorf("Grammar.Include() = %v, want %v", ok, false)
+	}
+}
+
+func TestGrammar_ValueIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		escapes bool
+		line    string
+		want    int
+	}{
+		{"plain", false, "k1=v1", 3},
+		{"sparse", false, "  k1  =  v1  ", 9},
+		{"empty value", false, "k1=", 3},
+		{"escaped separator", true, `a\=b = v`, 7},
+		{"no separator", false, "k1", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grammar := grammar_DEFAULT
+			grammar.Escapes = tt.escapes
+			if got := grammar.ValueIndex(tt.line); got != tt.want {
+				t.Errorf("Grammar.ValueIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeredoc(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		wantDelimiter string
+		wantOk        bool
+	}{
+		{"valid", "<<<END", "END", true},
+		{"valid unicode", "<<<پایان", "پایان", true},
+		{"no delimiter", "<<<", "", false},
+		{"space after prefix", "<<< END", "", false},
+		{"space in delimiter", "<<<E ND", "", false},
+		{"no prefix", "<<END", "", false},
+		{"plain value", "value", "", false},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDelimiter, gotOk := Heredoc(tt.value)
+			if gotDelimiter != tt.wantDelimiter || gotOk != tt.wantOk {
+				t.Errorf("Heredoc() = %q, %v, want %q, %v", gotDelimiter, gotOk, tt.wantDelimiter, tt.wantOk)
+			}
+		})
+	}
+}
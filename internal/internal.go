@@ -1,12 +1,17 @@
 package internal
 
 import (
-	"errors"
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"reflect"
 	"strings"
 	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
 
 	"github.com/saleh-rahimzadeh/go-words/core"
 )
@@ -35,6 +40,28 @@ func ValidationDelimiters(separator string, comment string) error {
 	return nil
 }
 
+// ValidationDelimiterList validate separator and prefixes of comments which are strings of one or more characters,
+// they must be punctuation or symbol characters except backslash and double quote, and separator must not be a comment prefix
+func ValidationDelimiterList(separator string, comments []string) error {
+	var valid = func(delimiter string) bool {
+		return delimiter != Empty && !strings.ContainsAny(delimiter, `\"`) && strings.IndexFunc(delimiter, func(character rune) bool {
+			return !unicode.IsPunct(character) && !unicode.IsSymbol(character)
+		}) < 0
+	}
+	if !valid(separator) || len(comments) == 0 {
+		return core.ErrDelimiterInvalid
+	}
+	for _, comment := range comments {
+		if !valid(comment) {
+			return core.ErrDelimiterInvalid
+		}
+		if comment == separator {
+			return core.ErrSameSeparatorAndComment
+		}
+	}
+	return nil
+}
+
 // ValidationName check name validation and return trimed name, return false if name is invalid
 func ValidationName(name string) (string, bool) {
 	name = strings.TrimSpace(name)
@@ -59,6 +86,18 @@ func ValidationSuffix(suffix string) (string, bool) {
 	return suffix, true
 }
 
+// ValidationPrefix check prefix validation and return left trimed prefix, return false if prefix is invalid
+func ValidationPrefix(prefix string) (string, bool) {
+	prefix = strings.TrimLeftFunc(prefix, unicode.IsSpace)
+	if prefix == Empty {
+		return Empty, false
+	}
+	if strings.Contains(prefix, NewLine) {
+		return Empty, false
+	}
+	return prefix, true
+}
+
 // ValidationFile check file stat and size
 func ValidationFile(file *os.File) error {
 	if file == nil || reflect.ValueOf(*file).IsZero() {
@@ -74,7 +113,27 @@ func ValidationFile(file *os.File) error {
 	return nil
 }
 
-// Extract search for a name in line and return value and true if found, else return empty string and false if not found
+// ValidationFSFile check file of file system to support random access and check size, return file as a reader at offsets
+func ValidationFSFile(file fs.File) (io.ReaderAt, error) {
+	if file == nil {
+		return nil, core.ErrFileNil
+	}
+	reader, ok := file.(io.ReaderAt)
+	if !ok {
+		return nil, core.ErrFileUnsupported
+	}
+	fileStat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fileStat.Size() < 1 {
+		return nil, core.ErrFileEmpty
+	}
+	return reader, nil
+}
+
+// Extract search for a name in line and return value and true if found, else return empty string and false if not found.
+// The name of line must not contain separator nor end with a part of it, else line must be escaped for "ExtractEscaped".
 func Extract(line string, name string, separator string) (string, bool) {
 	key, value, _ := strings.Cut(line, separator)
 	if key == name {
@@ -83,27 +142,49 @@ func Extract(line string, name string, separator string) (string, bool) {
 	return Empty, false
 }
 
-// Parse parse the line of words and return "key", "value" if has not error
-func Parse(line string, separator string, comment string) (string, string, error) {
-	var data = strings.TrimSpace(line)
-	if data == Empty {
-		return Empty, Empty, core.ErrLineEmpty
-	}
-	if strings.HasPrefix(data, comment) {
-		return Empty, Empty, core.ErrLineComment
+// SourceName return name of source if it has a "Name" method such as "*os.File", else return empty string
+func SourceName(source any) string {
+	if named, ok := source.(interface{ Name() string }); ok {
+		return named.Name()
 	}
+	return Empty
+}
 
-	key, value, found := strings.Cut(data, separator)
-	if !found {
-		return Empty, Empty, fmt.Errorf("%w, at line '%s'", core.ErrSeparatorNotPresent, line)
+// ScanLines a split function for "bufio.Scanner" to return each line of text with its trailing line break,
+// so length of each token is the exact number of bytes read. Lines end with "\n", "\r\n" or a lone "\r".
+func ScanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
 	}
-
-	key = strings.TrimSpace(key)
-	if key == Empty {
-		return Empty, Empty, fmt.Errorf("%w, at line '%s'", core.ErrNameNotPresent, line)
+	if index := bytes.IndexAny(data, LineBreaks); index >= 0 {
+		if data[index] == NewLineByte {
+			return index + 1, data[0 : index+1], nil
+		}
+		switch {
+		case index+1 < len(data) && data[index+1] == NewLineByte:
+			return index + 2, data[0 : index+2], nil
+		case index+1 < len(data) || atEOF:
+			return index + 1, data[0 : index+1], nil
+		}
+		// Request more data to check a new line after the carriage return
+		return 0, nil, nil
+	}
+	if atEOF {
+		return len(data), data, nil
 	}
+	return 0, nil, nil
+}
 
-	return key, strings.TrimSpace(value), nil
+// TrimLineBreak remove trailing line break of line
+func TrimLineBreak(line string) string {
+	line = strings.TrimSuffix(line, NewLine)
+	return strings.TrimSuffix(line, CarriageReturn)
+}
+
+// Parse parse the line of words and return "key", "value" if has not error.
+// Error of an invalid line is "*core.ParseError" without line number and source name.
+func Parse(line string, separator string, comment string) (string, string, error) {
+	return Grammar{Separator: separator, Comments: []string{comment}}.Parse(line)
 }
 
 // NormalizeLine parse line and return prepared line
@@ -115,46 +196,83 @@ func NormalizeLine(line string, separator string, comment string) (string, error
 	return fmt.Sprintf("%s%s%s", key, separator, value), nil
 }
 
-// Normalization parse each line and return prepared source collection
-func Normalization(source string, separator string, comment string) ([]string, error) {
-	var collection = make([]string, 0, strings.Count(source, NewLine)+1)
-	for _, line := range strings.Split(source, NewLine) {
-		data, err := NormalizeLine(line, separator, comment)
-		if err != nil {
-			if errors.Is(err, core.ErrLineEmpty) || errors.Is(err, core.ErrLineComment) {
-				continue
-			}
-			return nil, err
-		}
-		collection = append(collection, data)
+// Column return number of column (in runes) of byte index of line, starting from 1
+func Column(line string, index int) int {
+	if index < 0 || index > len(line) {
+		return 1
 	}
-	return collection, nil
+	return utf8.RuneCountInString(line[:index]) + 1
 }
 
-// Treasure prepare source as a collection
-func Treasure(source []string, separator string) (map[string]string, error) {
-	var treasure = make(map[string]string, len(source)+1)
-	for _, line := range source {
-		key, value, _ := strings.Cut(line, separator)
-		if _, found := treasure[key]; found {
-			return nil, fmt.Errorf("%w, name '%s'", core.ErrNameDuplicated, key)
-		}
-		treasure[key] = value
+// RecordAt create a record located at line of offset in data, with column of first non-space character of line.
+// Lines end with "\n", "\r\n" or a lone "\r" same as "ScanLines".
+func RecordAt(data []byte, source string, offset int64) Record {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	var (
+		start int = bytes.LastIndexAny(data[:offset], LineBreaks) + 1
+		end   int = bytes.IndexAny(data[offset:], LineBreaks)
+	)
+	if end < 0 {
+		end = len(data)
+	} else {
+		end += int(offset)
+	}
+	var text = TrimLineBreak(string(data[start:end]))
+	return Record{
+		Position: Position{Source: source, Line: countLines(data[:start]) + 1, Offset: int64(start)},
+		Column:   Column(text, len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace))),
+		Text:     text,
 	}
-	return treasure, nil
 }
 
-// CheckDuplication check for any duplicated names in source
-func CheckDuplication(source []string, separator string) (bool, string) {
-	for index, line := range source {
-		compareKey, _, _ := strings.Cut(string(line), separator)
-		for traverse := index + 1; traverse < len(source); traverse++ {
-			comprandLine := source[traverse]
-			comprandKey, _, _ := strings.Cut(string(comprandLine), separator)
-			if compareKey == comprandKey {
-				return true, compareKey
-			}
-		}
+// countLines return number of line breaks in data, "\r\n" is one line break
+func countLines(data []byte) int {
+	return bytes.Count(data, []byte(NewLine)) + bytes.Count(data, []byte(CarriageReturn)) - bytes.Count(data, []byte(LineBreaks))
+}
+
+// DecodeUnicode return data as UTF-8 without byte order mark, data of UTF-16 with byte order mark is decoded
+func DecodeUnicode(data []byte) []byte {
+	var order binary.ByteOrder
+	switch {
+	case bytes.HasPrefix(data, ByteOrderMarkUTF8):
+		return data[len(ByteOrderMarkUTF8):]
+	case bytes.HasPrefix(data, ByteOrderMarkUTF16LE):
+		order = binary.LittleEndian
+	case bytes.HasPrefix(data, ByteOrderMarkUTF16BE):
+		order = binary.BigEndian
+	default:
+		return data
+	}
+
+	var units = make([]uint16, 0, len(data)/2-1)
+	for index := 2; index+1 < len(data); index += 2 {
+		units = append(units, order.Uint16(data[index:]))
+	}
+	var buffer bytes.Buffer
+	for _, character := range utf16.Decode(units) {
+		buffer.WriteRune(character)
 	}
-	return false, Empty
+	return buffer.Bytes()
+}
+
+// DecodeUnicodeText return text as UTF-8 without byte order mark same as "DecodeUnicode", text without mark is not copied
+func DecodeUnicodeText(text string) string {
+	var prefix = text
+	if len(prefix) > len(ByteOrderMarkUTF8) {
+		prefix = prefix[:len(ByteOrderMarkUTF8)]
+	}
+	if !HasByteOrderMark([]byte(prefix)) {
+		return text
+	}
+	return string(DecodeUnicode([]byte(text)))
+}
+
+// HasByteOrderMark check data to start with a byte order mark of UTF-8 or UTF-16
+func HasByteOrderMark(data []byte) bool {
+	return bytes.HasPrefix(data, ByteOrderMarkUTF8) || bytes.HasPrefix(data, ByteOrderMarkUTF16LE) || bytes.HasPrefix(data, ByteOrderMarkUTF16BE)
 }
@@ -1,11 +1,17 @@
 package internal_test
 
 import (
+	"bufio"
+	"errors"
+	"io"
+	"io/fs"
 	"os"
 	"path"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
 
 	"github.com/saleh-rahimzadeh/go-words/core"
 	. "github.com/saleh-rahimzadeh/go-words/internal"
@@ -16,6 +22,8 @@ const (
 	path_BENCHMARK string = "../testdata/benchmark/"
 )
 
+var grammar_DEFAULT = Grammar{Separator: string(core.Separator), Comments: []string{string(core.Comment)}}
+
 func init() {
 	var err error
 	_, err = os.Stat(path_WORDS)
@@ -156,7 +164,7 @@ func TestNormalizeLine(t *testing.T) {
 	}
 }
 
-func TestNormalization(t *testing.T) {
+func TestCollect(t *testing.T) {
 	valid_oneline__source, _ := os.ReadFile(path.Join(path_WORDS, "valid_oneline__source"))
 	valid_oneline__want, _ := os.ReadFile(path.Join(path_WORDS, "valid_oneline__want"))
 	valid__source, _ := os.ReadFile(path.Join(path_WORDS, "valid__source"))
@@ -196,14 +204,18 @@ func TestNormalization(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Normalization(tt.args.source, tt.args.separator, tt.args.comment)
+			got, err := Collect(NewScanner(strings.NewReader(tt.args.source), Empty, Grammar{Separator: tt.args.separator, Comments: []string{tt.args.comment}}))
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Normalization() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Collect() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			desire := strings.Join(got, NewLine)
+			lines := make([]string, len(got))
+			for index, record := range got {
+				lines[index] = record.Key + tt.args.separator + record.Value
+			}
+			desire := strings.Join(lines, NewLine)
 			if desire != tt.want {
-				t.Errorf("Normalization() = %v, want %v", got, tt.want)
+				t.Errorf("Collect() = %v, want %v", lines, tt.want)
 			}
 		})
 	}
@@ -255,30 +267,106 @@ func TestValidationDelimiters(t *testing.T) {
 	}
 }
 
-func TestCheckDuplication(t *testing.T) {
-	duplicate_nofound, _ := os.ReadFile(path.Join(path_WORDS, "duplicate_nofound"))
-	duplicate_found, _ := os.ReadFile(path.Join(path_WORDS, "duplicate_found"))
+func TestValidationDelimiterList(t *testing.T) {
 	type args struct {
-		source    []string
 		separator string
+		comments  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{"valid", args{"=>", []string{"#", "//"}}, nil},
+		{"valid symbols", args{"::", []string{"--", ";"}}, nil},
+		{"empty separator", args{Empty, []string{"#"}}, core.ErrDelimiterInvalid},
+		{"no comments", args{"=", nil}, core.ErrDelimiterInvalid},
+		{"empty comment", args{"=", []string{"#", Empty}}, core.ErrDelimiterInvalid},
+		{"space", args{"= >", []string{"#"}}, core.ErrDelimiterInvalid},
+		{"letter", args{"=a", []string{"#"}}, core.ErrDelimiterInvalid},
+		{"digit", args{"=", []string{"#1"}}, core.ErrDelimiterInvalid},
+		{"backslash", args{`\=`, []string{"#"}}, core.ErrDelimiterInvalid},
+		{"double quote", args{"=", []string{`"`}}, core.ErrDelimiterInvalid},
+		{"same separator and comment", args{"//", []string{"#", "//"}}, core.ErrSameSeparatorAndComment},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidationDelimiterList(tt.args.separator, tt.args.comments); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidationDelimiterList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCollect_Duplication(t *testing.T) {
+	duplicate_nofound, _ := os.ReadFile(path.Join(path_WORDS, "duplicate_nofound"))
+	duplicate_found, _ := os.ReadFile(path.Join(path_WORDS, "duplicate_found"))
 	tests := []struct {
-		name     string
-		args     args
-		want     bool
-		wantName string
+		name         string
+		source       string
+		wantErr      bool
+		wantName     string
+		wantLine     int
+		wantPrevious int
 	}{
-		{"duplicate notfound", args{strings.Split(string(duplicate_nofound), NewLine), string(core.Separator)}, false, Empty},
-		{"duplicate found", args{strings.Split(string(duplicate_found), NewLine), string(core.Separator)}, true, "k2"},
+		{"duplicate notfound", string(duplicate_nofound), false, Empty, 0, 0},
+		{"duplicate found", string(duplicate_found), true, "k2", 5, 2},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotFound, gotKey := CheckDuplication(tt.args.source, tt.args.separator)
-			if gotFound != tt.want {
-				t.Errorf("CheckDuplication() gotFound = %v, want %v", gotFound, tt.want)
+			_, err := Collect(NewScanner(strings.NewReader(tt.source), "words", grammar_DEFAULT))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Collect() error = %v, wantErr %v", err, tt.wantErr)
+				return
 			}
-			if gotKey != tt.wantName {
-				t.Errorf("CheckDuplication() gotKey = %v, want %v", gotKey, tt.wantName)
+			if err == nil {
+				return
+			}
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, core.ErrNameDuplicated) {
+				t.Errorf("Collect() error = %v, want %v", err, core.ErrNameDuplicated)
+				return
+			}
+			if parseError.Name != tt.wantName || parseError.Line != tt.wantLine || parseError.Previous != tt.wantPrevious || parseError.Source != "words" {
+				t.Errorf("Collect() error = %+v, want name %v at line %v previous %v", parseError, tt.wantName, tt.wantLine, tt.wantPrevious)
+			}
+		})
+	}
+}
+
+func TestCollect_DuplicatesPolicy(t *testing.T) {
+	const source string = "k1 = a\nk2 = b\nk1 = c\nk3 = d\nk1 = e"
+	tests := []struct {
+		name   string
+		policy core.Duplicates
+		want   []string
+		lines  []int
+	}{
+		{"first", core.DuplicatesFirst, []string{"k1=a", "k2=b", "k3=d"}, []int{1, 2, 4}},
+		{"last", core.DuplicatesLast, []string{"k1=e", "k2=b", "k3=d"}, []int{5, 2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var grammar = grammar_DEFAULT
+			grammar.Duplicates = tt.policy
+			records, err := Collect(NewScanner(strings.NewReader(source), Empty, grammar))
+			if err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+			var (
+				got   []string
+				lines []int
+			)
+			for _, record := range records {
+				got = append(got, record.Key+"="+record.Value)
+				lines = append(lines, record.Line)
+			}
+			if !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(lines, tt.lines) {
+				t.Errorf("Collect() = %v at lines %v, want %v at lines %v", got, lines, tt.want, tt.lines)
+			}
+			faults, err := Check(NewScanner(strings.NewReader(source), Empty, grammar))
+			if err != nil || len(faults) > 0 {
+				t.Errorf("Check() = %v, %v, want no errors", faults, err)
 			}
 		})
 	}
@@ -310,16 +398,16 @@ func TestValidationName(t *testing.T) {
 	}
 }
 
-func TestTreasure(t *testing.T) {
+func TestCollect_Collection(t *testing.T) {
 	data_valid, _ := os.ReadFile(path.Join(path_WORDS, "collection"))
 	data_duplicated, _ := os.ReadFile(path.Join(path_WORDS, "collection_duplicate"))
 	tests := []struct {
 		name    string
-		source  []string
+		source  string
 		want    map[string]string
 		wantErr bool
 	}{
-		{"valid", strings.Split(string(data_valid), NewLine), map[string]string{
+		{"valid", string(data_valid), map[string]string{
 			"k1":      "v1",
 			"k2":      "v2",
 			"k 3":     "v 3",
@@ -328,18 +416,25 @@ func TestTreasure(t *testing.T) {
 			"k6":      "",
 			"k7":      "v7",
 		}, false},
-		{"duplicated", strings.Split(string(data_duplicated), NewLine), nil, true},
+		{"duplicated", string(data_duplicated), nil, true},
 	}
 	var separator = string(core.Separator)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Treasure(tt.source, separator)
+			records, err := Collect(NewScanner(strings.NewReader(tt.source), Empty, Grammar{Separator: separator, Comments: []string{string(core.Comment)}}))
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Treasure() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Collect() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			var got map[string]string
+			if records != nil {
+				got = make(map[string]string, len(records))
+				for _, record := range records {
+					got[record.Key] = record.Value
+				}
+			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Treasure() = %v, want %v", got, tt.want)
+				t.Errorf("Collect() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -372,6 +467,33 @@ func TestValidationSuffix(t *testing.T) {
 	}
 }
 
+func TestValidationPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		arg         string
+		wantPrefix  string
+		wantIsValid bool
+	}{
+		{"valid", "billing.", "billing.", true},
+		{"valid space", "billing. ", "billing. ", true},
+		{"valid trim", " billing. ", "billing. ", true},
+		{"invalid", "billing.\nshipping.", Empty, false},
+		{"space", "  ", Empty, false},
+		{"empty", Empty, Empty, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPrefix, gotIsValid := ValidationPrefix(tt.arg)
+			if gotPrefix != tt.wantPrefix {
+				t.Errorf("ValidationPrefix() gotPrefix = %v, wantPrefix %v", gotPrefix, tt.wantPrefix)
+			}
+			if gotIsValid != tt.wantIsValid {
+				t.Errorf("ValidationPrefix() gotIsValid = %v, wantIsValid %v", gotIsValid, tt.wantIsValid)
+			}
+		})
+	}
+}
+
 func TestValidationFile(t *testing.T) {
 	fileValid, err := os.Open(path.Join(path_WORDS, "valid__want"))
 	if err != nil {
@@ -412,10 +534,165 @@ func TestValidationFile(t *testing.T) {
 	}
 }
 
+func TestValidationFSFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"empty": &fstest.MapFile{},
+		"valid": &fstest.MapFile{Data: []byte("k1=v1")},
+	}
+	fileValid, err := fsys.Open("valid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileValid.Close()
+	if _, err := ValidationFSFile(fileValid); err != nil {
+		t.Errorf("ValidationFSFile() error = %v", err)
+		return
+	}
+	fileEmpty, err := fsys.Open("empty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileEmpty.Close()
+	fileDirectory, err := fsys.Open(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileDirectory.Close()
+	tests := []struct {
+		name string
+		file fs.File
+		want error
+	}{
+		{"check nil file", nil, core.ErrFileNil},
+		{"check unsupported file", fileDirectory, core.ErrFileUnsupported},
+		{"check empty file", fileEmpty, core.ErrFileEmpty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := ValidationFSFile(tt.file); !errors.Is(got, tt.want) {
+				t.Errorf("ValidationFSFile() error = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"lines", "k1=v1\nk2=v2\n", []string{"k1=v1\n", "k2=v2\n"}},
+		{"no trailing line break", "k1=v1\nk2=v2", []string{"k1=v1\n", "k2=v2"}},
+		{"carriage return", "k1=v1\r\n\r\n", []string{"k1=v1\r\n", "\r\n"}},
+		{"lone carriage return", "k1=v1\rk2=v2\r", []string{"k1=v1\r", "k2=v2\r"}},
+		{"mixed line breaks", "k1=v1\r\rk2=v2\n\r\nk3=v3", []string{"k1=v1\r", "\r", "k2=v2\n", "\r\n", "k3=v3"}},
+		{"empty", Empty, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Read by one byte too, to split carriage return and line feed between reads
+			for _, reader := range []io.Reader{strings.NewReader(tt.source), iotest.OneByteReader(strings.NewReader(tt.source))} {
+				var got []string
+				scanner := bufio.NewScanner(reader)
+				scanner.Split(ScanLines)
+				for scanner.Scan() {
+					got = append(got, scanner.Text())
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ScanLines() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordAt(t *testing.T) {
+	const data string = "a\r  b\r\nc\n\rd"
+	tests := []struct {
+		name       string
+		offset     int64
+		wantLine   int
+		wantColumn int
+		wantText   string
+	}{
+		{"first line", 0, 1, 1, "a"},
+		{"carriage return", 4, 2, 3, "  b"},
+		{"carriage return and new line", 7, 3, 1, "c"},
+		{"empty line", 9, 4, 1, Empty},
+		{"new line and carriage return", 10, 5, 1, "d"},
+		{"out of data", 100, 5, 1, "d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RecordAt([]byte(data), "source", tt.offset)
+			if got.Line != tt.wantLine || got.Column != tt.wantColumn || got.Text != tt.wantText || got.Source != "source" {
+				t.Errorf("RecordAt() = %+v, want line %v column %v text %q", got, tt.wantLine, tt.wantColumn, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestTrimLineBreak(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"line feed", "k1=v1\n", "k1=v1"},
+		{"carriage return line feed", "k1=v1\r\n", "k1=v1"},
+		{"carriage return", "k1=v1\r", "k1=v1"},
+		{"no line break", "k1=v1", "k1=v1"},
+		{"empty", Empty, Empty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrimLineBreak(tt.arg); got != tt.want {
+				t.Errorf("TrimLineBreak() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumn(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		index int
+		want  int
+	}{
+		{"first", "k1=v1", 0, 1},
+		{"middle", "k1=v1", 2, 3},
+		{"unicode", "کلید=v1", 8, 5},
+		{"out of range", "k1=v1", 10, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Column(tt.line, tt.index); got != tt.want {
+				t.Errorf("Column() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceName(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "valid__want"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if got := SourceName(file); got != file.Name() {
+		t.Errorf("SourceName() = %v, want %v", got, file.Name())
+	}
+	if got := SourceName(strings.NewReader(Empty)); got != Empty {
+		t.Errorf("SourceName() = %v, want %v", got, Empty)
+	}
+}
+
 //┌ Benchmark
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
-func BenchmarkNormalizationSmall(b *testing.B) {
+func BenchmarkCollectSmall(b *testing.B) {
 	data, err := os.ReadFile(path.Join(path_BENCHMARK, "normalization__small"))
 	if err != nil {
 		b.Fatal(err)
@@ -424,11 +701,11 @@ func BenchmarkNormalizationSmall(b *testing.B) {
 	var separator string = string(core.Separator)
 	var comment string = string(core.Comment)
 	for i := 0; i < b.N; i++ {
-		Normalization(source, separator, comment)
+		Collect(NewScanner(strings.NewReader(source), Empty, Grammar{Separator: separator, Comments: []string{comment}}))
 	}
 }
 
-func BenchmarkNormalizationLarge(b *testing.B) {
+func BenchmarkCollectLarge(b *testing.B) {
 	data, err := os.ReadFile(path.Join(path_BENCHMARK, "normalization__large"))
 	if err != nil {
 		b.Fatal(err)
@@ -437,6 +714,27 @@ func BenchmarkNormalizationLarge(b *testing.B) {
 	var separator string = string(core.Separator)
 	var comment string = string(core.Comment)
 	for i := 0; i < b.N; i++ {
-		Normalization(source, separator, comment)
+		Collect(NewScanner(strings.NewReader(source), Empty, Grammar{Separator: separator, Comments: []string{comment}}))
+	}
+}
+
+func TestDecodeUnicode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"UTF-8", []byte("k = v"), "k = v"},
+		{"UTF-8 with BOM", []byte("\xEF\xBB\xBFk = v"), "k = v"},
+		{"UTF-16LE", []byte{0xFF, 0xFE, 'k', 0, '=', 0, 0x33, 0x06}, "k=س"},
+		{"UTF-16BE", []byte{0xFE, 0xFF, 0, 'k', 0, '=', 0xD8, 0x3D, 0xDE, 0x00}, "k=😀"},
+		{"UTF-16 odd size", []byte{0xFF, 0xFE, 'k', 0, '='}, "k"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(DecodeUnicode(tt.data)); got != tt.want {
+				t.Errorf("DecodeUnicode() = %q, want %q", got, tt.want)
+			}
+		})
 	}
 }
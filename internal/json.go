@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// FlattenJSON read records of a JSON object, names of nested objects are joined by dot like "errors.notfound".
+// Values can be strings, numbers, booleans and null as empty string, return error for arrays or duplicated names.
+// Errors of invalid JSON and names are "*core.ParseError" with location in data.
+func FlattenJSON(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, core.ErrWordsEmpty
+	}
+
+	var flattener = jsonFlattener{
+		data:    data,
+		source:  source,
+		decoder: json.NewDecoder(bytes.NewReader(data)),
+		names:   make(map[string]Position),
+	}
+	flattener.decoder.UseNumber()
+
+	token, err := flattener.decoder.Token()
+	if err != nil {
+		return nil, flattener.fault(err)
+	}
+	if delimiter, ok := token.(json.Delim); !ok || delimiter != '{' {
+		return nil, flattener.fault(core.ErrJSONInvalid)
+	}
+	if err := flattener.object(Empty); err != nil {
+		return nil, err
+	}
+	if _, err := flattener.decoder.Token(); err != io.EOF {
+		return nil, flattener.fault(core.ErrJSONInvalid)
+	}
+
+	return flattener.records, nil
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// jsonFlattener the state of reading records of a JSON object
+type jsonFlattener struct {
+	data    []byte
+	source  string
+	decoder *json.Decoder
+	names   map[string]Position
+	records []Record
+}
+
+// object read members of an object until its closing delimiter, prefix is joined names of parent objects
+func (f *jsonFlattener) object(prefix string) error {
+	for f.decoder.More() {
+		token, err := f.decoder.Token()
+		if err != nil {
+			return f.fault(err)
+		}
+		var (
+			record Record = f.record(f.decoder.InputOffset())
+			name   string = strings.TrimSpace(token.(string))
+		)
+		if name == Empty {
+			return f.fault(core.ErrNameNotPresent)
+		}
+		record.Key = prefix + name
+
+		token, err = f.decoder.Token()
+		if err != nil {
+			return f.fault(err)
+		}
+		switch value := token.(type) {
+		case json.Delim:
+			if value != '{' {
+				return f.fault(core.ErrJSONInvalid)
+			}
+			if err := f.object(record.Key + SectionDelimiter); err != nil {
+				return err
+			}
+			continue
+		case string:
+			record.Value = value
+		case json.Number:
+			record.Value = value.String()
+		case bool:
+			record.Value = strconv.FormatBool(value)
+		case nil:
+			record.Value = Empty
+		}
+
+		if previous, found := f.names[record.Key]; found {
+			return Duplication(record, previous)
+		}
+		f.names[record.Key] = record.Position
+		f.records = append(f.records, record)
+	}
+
+	_, err := f.decoder.Token()
+	if err != nil {
+		return f.fault(err)
+	}
+	return nil
+}
+
+// record create a record located at line of offset in data
+func (f *jsonFlattener) record(offset int64) Record {
+	return RecordAt(f.data, f.source, offset)
+}
+
+// fault create error of reason located at current offset of decoder,
+// offset of syntax errors is used for them
+func (f *jsonFlattener) fault(reason error) error {
+	var offset = f.decoder.InputOffset()
+	var syntaxError *json.SyntaxError
+	if errors.As(reason, &syntaxError) {
+		offset = syntaxError.Offset
+	}
+	if reason == io.EOF {
+		reason = io.ErrUnexpectedEOF
+	}
+	var record = f.record(offset)
+	return &core.ParseError{Source: f.source, Line: record.Line, Column: record.Column, Text: record.Text, Err: reason}
+}
@@ -0,0 +1,28 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestFlattenJSON(t *testing.T) {
+	const source string = "{\n  \"k1\": \"v1\",\n  \"s1\": {\"k2\": 2, \"k3\": false},\n  \"k4\": null\n}"
+	want := []Record{
+		{Position: Position{Source: "words.json", Line: 2, Offset: 2}, Key: "k1", Value: "v1", Column: 3, Text: `  "k1": "v1",`},
+		{Position: Position{Source: "words.json", Line: 3, Offset: 16}, Key: "s1.k2", Value: "2", Column: 3, Text: `  "s1": {"k2": 2, "k3": false},`},
+		{Position: Position{Source: "words.json", Line: 3, Offset: 16}, Key: "s1.k3", Value: "false", Column: 3, Text: `  "s1": {"k2": 2, "k3": false},`},
+		{Position: Position{Source: "words.json", Line: 4, Offset: 48}, Key: "k4", Value: "", Column: 3, Text: `  "k4": null`},
+	}
+	got, err := FlattenJSON([]byte(source), "words.json")
+	if err != nil {
+		t.Fatalf("FlattenJSON() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenJSON() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,200 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined characters of Java properties
+const (
+	propertiesWhitespace string = " \t\f"
+	propertiesSeparators string = "=:"
+	propertiesComments   string = "#!"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseProperties read records of Java properties data by rules of "java.util.Properties":
+// comment lines start with "#" or "!", name is separated from value by "=", ":" or whitespace,
+// a line ending with backslash is continued in the next line, and escape sequences such as "\uXXXX" are decoded.
+// Like Java, the last value of a duplicated name is kept, in place of the first occurrence of the name.
+// Unlike Java, data is read as UTF-8 or UTF-16 with byte order mark.
+func ParseProperties(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var (
+		scanner *bufio.Scanner = bufio.NewScanner(bytes.NewReader(data))
+		next    Position       = Position{Source: source, Line: 1}
+		names   map[string]int = make(map[string]int)
+		records []Record
+	)
+	scanner.Buffer(nil, LineMaxSize)
+	scanner.Split(ScanLines)
+
+	var read = func() (string, Position, bool) {
+		if !scanner.Scan() {
+			return Empty, Position{}, false
+		}
+		var position = next
+		next.Line++
+		next.Offset += int64(len(scanner.Bytes()))
+		return TrimLineBreak(scanner.Text()), position, true
+	}
+
+	for {
+		text, position, ok := read()
+		if !ok {
+			break
+		}
+
+		var logical = strings.TrimLeft(text, propertiesWhitespace)
+		if logical == Empty || strings.ContainsRune(propertiesComments, rune(logical[0])) {
+			continue
+		}
+		for Escaped(logical) {
+			line, _, ok := read()
+			if !ok {
+				logical = logical[:len(logical)-1]
+				break
+			}
+			logical = logical[:len(logical)-1] + strings.TrimLeft(line, propertiesWhitespace)
+		}
+
+		var record = Record{
+			Position: position,
+			Column:   Column(text, len(text)-len(strings.TrimLeft(text, propertiesWhitespace))),
+			Text:     text,
+		}
+		var fault = func(err error) error {
+			return &core.ParseError{Source: source, Line: record.Line, Column: record.Column, Text: text, Err: err}
+		}
+
+		key, value := splitProperty(logical)
+		if key == Empty {
+			return nil, fault(core.ErrNameNotPresent)
+		}
+		var err error
+		if record.Key, err = unescapeProperty(key); err != nil {
+			return nil, fault(err)
+		}
+		if record.Value, err = unescapeProperty(value); err != nil {
+			return nil, fault(err)
+		}
+
+		if index, found := names[record.Key]; found {
+			records[index] = record
+			continue
+		}
+		names[record.Key] = len(records)
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// splitProperty split a logical line of properties to raw name and value,
+// name ends at the first unescaped separator or whitespace, and one separator between whitespace is skipped
+func splitProperty(line string) (string, string) {
+	var index int
+	for ; index < len(line); index++ {
+		if line[index] == EscapeByte {
+			index++
+			continue
+		}
+		if strings.IndexByte(propertiesWhitespace+propertiesSeparators, line[index]) >= 0 {
+			break
+		}
+	}
+	if index > len(line) {
+		index = len(line)
+	}
+
+	var (
+		key   string = line[:index]
+		value string = strings.TrimLeft(line[index:], propertiesWhitespace)
+	)
+	if value != Empty && strings.IndexByte(propertiesSeparators, value[0]) >= 0 {
+		value = strings.TrimLeft(value[1:], propertiesWhitespace)
+	}
+	return key, value
+}
+
+// unescapeProperty decode escape sequences of properties, "\t", "\n", "\r", "\f" and "\uXXXX" are decoded,
+// surrogate pairs of "\uXXXX" are combined, and backslash followed by other characters is the character itself
+func unescapeProperty(text string) (string, error) {
+	if strings.IndexByte(text, EscapeByte) < 0 {
+		return text, nil
+	}
+	var (
+		builder   strings.Builder
+		surrogate rune = -1
+	)
+	builder.Grow(len(text))
+	var flush = func() {
+		if surrogate >= 0 {
+			builder.WriteRune(utf8.RuneError)
+			surrogate = -1
+		}
+	}
+	for index := 0; index < len(text); index++ {
+		if text[index] != EscapeByte {
+			flush()
+			builder.WriteByte(text[index])
+			continue
+		}
+		character, size := utf8.DecodeRuneInString(text[index+1:])
+		index += size
+		if character != 'u' {
+			flush()
+		}
+		switch character {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			var hex = text[index+1:]
+			if len(hex) < EscapeHexSize {
+				return Empty, core.ErrEscapeInvalid
+			}
+			code, err := strconv.ParseUint(hex[:EscapeHexSize], 16, 32)
+			if err != nil {
+				return Empty, core.ErrEscapeInvalid
+			}
+			index += EscapeHexSize
+			var unit = rune(code)
+			switch {
+			case surrogate >= 0 && utf16.IsSurrogate(unit):
+				builder.WriteRune(utf16.DecodeRune(surrogate, unit))
+				surrogate = -1
+			case utf16.IsSurrogate(unit):
+				surrogate = unit
+			default:
+				flush()
+				builder.WriteRune(unit)
+			}
+		default:
+			if size > 0 {
+				builder.WriteRune(character)
+			}
+		}
+	}
+	flush()
+	return builder.String(), nil
+}
@@ -0,0 +1,47 @@
+package internal_test
+
+import (
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestParseProperties(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		wantKey   string
+		wantValue string
+	}{
+		{"equal", "k = v", "k", "v"},
+		{"colon", "k:v", "k", "v"},
+		{"whitespace", "k \t v", "k", "v"},
+		{"whitespace and separator", "k  =  v", "k", "v"},
+		{"second separator", "k = = v", "k", "= v"},
+		{"no value", "k", "k", ""},
+		{"form feed", "\fk=v", "k", "v"},
+		{"continuation", "k = a\\\n   b\\\n c", "k", "abc"},
+		{"escaped backslash", "k = a\\\\\n", "k", `a\`},
+		{"continuation at end", "k = a\\", "k", "a"},
+		{"comment with backslash", "# comment \\\nk = v", "k", "v"},
+		{"escapes", `k = \t\n\r\f\=\:\ `, "k", "\t\n\r\f=: "},
+		{"surrogate pair", `k = \uD83D\uDE00`, "k", "😀"},
+		{"lone surrogate", `k = \uD83Dx`, "k", "\uFFFDx"},
+		{"escaped key", `a\ b\=c = v`, "a b=c", "v"},
+		{"unicode", "کلید = مقدار", "کلید", "مقدار"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProperties([]byte(tt.source), Empty)
+			if err != nil {
+				t.Fatalf("ParseProperties() error = %v", err)
+			}
+			if len(got) != 1 || got[0].Key != tt.wantKey || got[0].Value != tt.wantValue {
+				t.Errorf("ParseProperties() = %+v, want %q = %q", got, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
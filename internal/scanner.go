@@ -0,0 +1,364 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Position the location of a record in source
+type Position struct {
+	Source  string // Name of source, such as path of included file
+	Line    int    // Number of line, starting from 1
+	Offset  int64  // Offset of line in bytes, starting from 0
+	Section string // Section of line, empty for lines before any section header
+}
+
+// Record a pair of name and value parsed from source
+type Record struct {
+	Position
+	Key    string
+	Value  string
+	Column int    // Number of column of key in line, starting from 1
+	Text   string // Raw text of line
+}
+
+// Scanner read source line by line and parse records
+type Scanner struct {
+	scanner *bufio.Scanner
+	source  string
+	grammar Grammar
+	next    Position
+	chain   []string // Names of sources including this source, to detect cyclic includes
+	child   *Scanner // Scanner of included source which is being read
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Start set position of the first line of reader, for scanning from middle of source,
+// the section of position is used for keys until the next section header
+func (s *Scanner) Start(position Position) {
+	s.next = position
+	s.next.Source = s.source
+}
+
+// Next parse and return next record, return "io.EOF" at end of source.
+// Error of an invalid line is "*core.ParseError" and scanning can be continued after it,
+// other errors are failures of reading source.
+func (s *Scanner) Next() (Record, error) {
+	for {
+		if s.child != nil {
+			record, err := s.child.Next()
+			if err == io.EOF {
+				s.child = nil
+				continue
+			}
+			return record, err
+		}
+
+		text, position, ok := s.read()
+		if !ok {
+			break
+		}
+
+		if section, ok := s.grammar.Section(text); ok {
+			s.next.Section = section
+			continue
+		}
+
+		if name, ok := s.grammar.Include(text); ok {
+			if err := s.include(name, text, position); err != nil {
+				return Record{}, err
+			}
+			continue
+		}
+
+		key, value, err := s.grammar.Parse(text)
+		if err != nil {
+			if errors.Is(err, core.ErrLineEmpty) || errors.Is(err, core.ErrLineComment) {
+				continue
+			}
+			var parseError *core.ParseError
+			if errors.As(err, &parseError) {
+				parseError.Source = s.source
+				parseError.Line = position.Line
+			}
+			return Record{}, err
+		}
+
+		var (
+			raw      string = value
+			verbatim bool
+		)
+		if s.grammar.Multiline {
+			value, verbatim, err = s.multiline(text, value, position)
+			if err != nil {
+				return Record{}, err
+			}
+		}
+
+		if s.grammar.Escapes && !verbatim {
+			value, err = s.unquote(text, raw, value, position)
+			if err != nil {
+				return Record{}, err
+			}
+		}
+
+		if position.Section != Empty {
+			key = position.Section + SectionDelimiter + key
+		}
+
+		var column = Column(text, len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace)))
+		if s.grammar.Normalize != nil {
+			if key = s.grammar.Normalize(key); key == Empty {
+				return Record{}, &core.ParseError{Source: s.source, Line: position.Line, Column: column, Text: text, Err: core.ErrNameNotPresent}
+			}
+		}
+
+		return Record{
+			Position: position,
+			Key:      key,
+			Value:    value,
+			Column:   column,
+			Text:     text,
+		}, nil
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return Record{}, err
+	}
+	return Record{}, io.EOF
+}
+
+// read read next line of source, return text of line without line break and its position
+func (s *Scanner) read() (string, Position, bool) {
+	if !s.scanner.Scan() {
+		return Empty, Position{}, false
+	}
+	var (
+		text     string   = s.scanner.Text()
+		position Position = s.next
+	)
+	s.next.Line++
+	s.next.Offset += int64(len(text))
+	return TrimLineBreak(text), position, true
+}
+
+// multiline read the rest of a multi-line value from following lines,
+// for a heredoc block or a value ending with a continuation backslash.
+// Return true for a heredoc block, which is verbatim and not decoded.
+func (s *Scanner) multiline(text string, value string, position Position) (string, bool, error) {
+	if delimiter, ok := Heredoc(strings.TrimSpace(value)); ok {
+		var lines []string
+		for {
+			line, _, ok := s.read()
+			if !ok {
+				if err := s.scanner.Err(); err != nil {
+					return Empty, true, err
+				}
+				return Empty, true, &core.ParseError{Source: s.source, Line: position.Line, Column: Column(text, s.grammar.ValueIndex(text)), Text: text, Err: core.ErrBlockNotClosed}
+			}
+			if strings.TrimSpace(line) == delimiter {
+				return strings.Join(lines, NewLine), true, nil
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	if !s.continued(value) {
+		return value, false, nil
+	}
+	var builder strings.Builder
+	for s.continued(value) {
+		builder.WriteString(strings.TrimSuffix(value, Continuation))
+		line, _, ok := s.read()
+		if !ok {
+			if err := s.scanner.Err(); err != nil {
+				return Empty, false, err
+			}
+			value = Empty
+			break
+		}
+		value = s.grammar.TrimComment(strings.TrimSpace(line))
+	}
+	builder.WriteString(value)
+	return strings.TrimSpace(builder.String()), false, nil
+}
+
+// include read the named source of include directive from file system of grammar, then records of it are read
+// before the next lines under the current section, error of a cyclic, invalid or unreadable include is "*core.ParseError" of the directive
+func (s *Scanner) include(name string, text string, position Position) error {
+	var fault = &core.ParseError{Source: s.source, Line: position.Line, Column: Column(text, len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace))), Text: text}
+	if !fs.ValidPath(name) {
+		fault.Err = core.ErrIncludeInvalid
+		return fault
+	}
+	for _, source := range s.chain {
+		if source == name {
+			fault.Err = core.ErrIncludeCycle
+			return fault
+		}
+	}
+	data, err := fs.ReadFile(s.grammar.Includes, name)
+	if err != nil {
+		fault.Err = err
+		return fault
+	}
+	s.child = NewScanner(bytes.NewReader(DecodeUnicode(data)), name, s.grammar)
+	s.child.next.Section = s.next.Section
+	s.child.chain = append(append(make([]string, 0, len(s.chain)+1), s.chain...), name)
+	return nil
+}
+
+// continued check value to end with a continuation backslash,
+// with escapes an escaped backslash like "\\" is not a continuation
+func (s *Scanner) continued(value string) bool {
+	if s.grammar.Escapes {
+		return Escaped(value)
+	}
+	return strings.HasSuffix(value, Continuation)
+}
+
+// unquote decode the value by "Unquote", the raw value of first line is used to locate column of error
+func (s *Scanner) unquote(text string, raw string, value string, position Position) (string, error) {
+	decoded, index, err := Unquote(value)
+	if err != nil {
+		var start = s.grammar.ValueIndex(text)
+		if index < len(raw) {
+			start += index
+		}
+		return Empty, &core.ParseError{Source: s.source, Line: position.Line, Column: Column(text, start), Text: text, Err: err}
+	}
+	return decoded, nil
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewScanner create a new instance of Scanner to read source from reader by rules of grammar,
+// the name of source is used in errors
+func NewScanner(reader io.Reader, source string, grammar Grammar) *Scanner {
+	var scanner = bufio.NewScanner(reader)
+	scanner.Buffer(nil, LineMaxSize)
+	scanner.Split(ScanLines)
+	var chain []string
+	if source != Empty {
+		chain = []string{source}
+	}
+	return &Scanner{
+		scanner: scanner,
+		source:  source,
+		grammar: grammar,
+		next:    Position{Source: source, Line: 1},
+		chain:   chain,
+	}
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Collect read all records of scanner, return error at first invalid line or duplicated name.
+// By policy of duplicated names of grammar, the first or the last record of a duplicated name is kept
+// in place of its first occurrence instead of error, and each later occurrence is reported as an override.
+func Collect(scanner *Scanner) ([]Record, error) {
+	var (
+		records []Record
+		names   map[string]int = make(map[string]int)
+	)
+	for {
+		record, err := scanner.Next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if index, found := names[record.Key]; found {
+			switch scanner.grammar.Duplicates {
+			case core.DuplicatesFirst:
+				scanner.grammar.Override(record, records[index].Position)
+			case core.DuplicatesLast:
+				scanner.grammar.Override(record, records[index].Position)
+				records[index] = record
+			default:
+				return nil, Duplication(record, records[index].Position)
+			}
+			continue
+		}
+		names[record.Key] = len(records)
+		records = append(records, record)
+	}
+}
+
+// Check read all records of scanner and return all invalid lines and duplicated names,
+// duplicated names are not errors if policy of duplicated names of grammar keeps them but they are reported as overrides.
+// Return error only for failures of reading source.
+func Check(scanner *Scanner) ([]*core.ParseError, error) {
+	var (
+		faults []*core.ParseError
+		names  map[string]Position = make(map[string]Position)
+	)
+	for {
+		record, err := scanner.Next()
+		if err == io.EOF {
+			return faults, nil
+		}
+		if err != nil {
+			var parseError *core.ParseError
+			if errors.As(err, &parseError) {
+				faults = append(faults, parseError)
+				continue
+			}
+			return faults, err
+		}
+		if previous, found := names[record.Key]; found {
+			switch scanner.grammar.Duplicates {
+			case core.DuplicatesFirst:
+				scanner.grammar.Override(record, previous)
+			case core.DuplicatesLast:
+				scanner.grammar.Override(record, previous)
+				names[record.Key] = record.Position
+			default:
+				faults = append(faults, Duplication(record, previous))
+			}
+			continue
+		}
+		names[record.Key] = record.Position
+	}
+}
+
+// Duplication return error of duplicated name of record which is first defined at previous position
+func Duplication(record Record, previous Position) *core.ParseError {
+	return &core.ParseError{
+		Source:   record.Source,
+		Line:     record.Line,
+		Column:   record.Column,
+		Text:     record.Text,
+		Name:     record.Key,
+		Previous: previous.Line,
+		First:    previous.Source,
+		Err:      core.ErrNameDuplicated,
+	}
+}
+
+// Override report the occurrence of a duplicated name by record, which is resolved by policy of duplicated names of grammar,
+// previous is position of the previously kept occurrence
+func (g Grammar) Override(record Record, previous Position) {
+	if g.Overrides == nil {
+		return
+	}
+	g.Overrides(core.Override{
+		Name:     record.Key,
+		Source:   record.Source,
+		Line:     record.Line,
+		Previous: previous.Line,
+		First:    previous.Source,
+		Kept:     g.Duplicates == core.DuplicatesLast,
+	})
+}
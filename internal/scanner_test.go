@@ -0,0 +1,295 @@
+package internal_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	. "github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestScanner_Next(t *testing.T) {
+	const source string = "k1=v1\n# comment\n\n  k2 = v2\r\nk3=v3"
+	scanner := NewScanner(strings.NewReader(source), "words", grammar_DEFAULT)
+	want := []Record{
+		{Position: Position{Source: "words", Line: 1, Offset: 0}, Key: "k1", Value: "v1", Column: 1, Text: "k1=v1"},
+		{Position: Position{Source: "words", Line: 4, Offset: 17}, Key: "k2", Value: "v2", Column: 3, Text: "  k2 = v2"},
+		{Position: Position{Source: "words", Line: 5, Offset: 28}, Key: "k3", Value: "v3", Column: 1, Text: "k3=v3"},
+	}
+	for _, wantRecord := range want {
+		got, err := scanner.Next()
+		if err != nil {
+			t.Fatalf("Scanner.Next() error = %v", err)
+		}
+		if got != wantRecord {
+			t.Errorf("Scanner.Next() = %+v, want %+v", got, wantRecord)
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Errorf("Scanner.Next() error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestScanner_Next_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      string
+		wantErr     error
+		wantLine    int
+		wantColumn  int
+		wantText    string
+		wantMessage string
+	}{
+		{"no separator", "k1=v1\n\n  hello", core.ErrSeparatorNotPresent, 3, 3, "  hello", "words: line 3, column 3: separator not present in record, at line '  hello'"},
+		{"absent name", "k1=v1\n  = v2", core.ErrNameNotPresent, 2, 3, "  = v2", "words: line 2, column 3: name not present in record, at line '  = v2'"},
+		{"absent name unicode", "ک = v1\néé \t= v2\n\t\t= v3", core.ErrNameNotPresent, 3, 3, "\t\t= v3", "words: line 3, column 3: name not present in record, at line '\t\t= v3'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanner := NewScanner(strings.NewReader(tt.source), "words", grammar_DEFAULT)
+			var err error
+			for err == nil {
+				_, err = scanner.Next()
+			}
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("Scanner.Next() error = %v, want *core.ParseError", err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Scanner.Next() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine || parseError.Column != tt.wantColumn || parseError.Text != tt.wantText || parseError.Source != "words" {
+				t.Errorf("Scanner.Next() error = %+v, want line %v column %v text %q", parseError, tt.wantLine, tt.wantColumn, tt.wantText)
+			}
+			if parseError.Error() != tt.wantMessage {
+				t.Errorf("ParseError.Error() = %q, want %q", parseError.Error(), tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestScanner_Next_Multiline(t *testing.T) {
+	const source string = "k1 = a \\\n  b \\\r\n  c\nk2 = <<<END\n  line 1\n\nline 2\n  END\nk3=v3"
+	grammar := grammar_DEFAULT
+	grammar.Multiline = true
+	scanner := NewScanner(strings.NewReader(source), Empty, grammar)
+	want := []Record{
+		{Position: Position{Line: 1, Offset: 0}, Key: "k1", Value: "a b c", Column: 1, Text: "k1 = a \\"},
+		{Position: Position{Line: 4, Offset: 20}, Key: "k2", Value: "  line 1\n\nline 2", Column: 1, Text: "k2 = <<<END"},
+		{Position: Position{Line: 9, Offset: 55}, Key: "k3", Value: "v3", Column: 1, Text: "k3=v3"},
+	}
+	for _, wantRecord := range want {
+		got, err := scanner.Next()
+		if err != nil {
+			t.Fatalf("Scanner.Next() error = %v", err)
+		}
+		if got != wantRecord {
+			t.Errorf("Scanner.Next() = %+v, want %+v", got, wantRecord)
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Errorf("Scanner.Next() error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestScanner_Next_MultilineUnclosed(t *testing.T) {
+	const source string = "k1=v1\nk2 = <<<END\nline 1\nEND2"
+	grammar := grammar_DEFAULT
+	grammar.Multiline = true
+	scanner := NewScanner(strings.NewReader(source), Empty, grammar)
+	var err error
+	for err == nil {
+		_, err = scanner.Next()
+	}
+	var parseError *core.ParseError
+	if !errors.As(err, &parseError) || !errors.Is(err, core.ErrBlockNotClosed) {
+		t.Fatalf("Scanner.Next() error = %v, want %v", err, core.ErrBlockNotClosed)
+	}
+	if parseError.Line != 2 || parseError.Text != "k2 = <<<END" {
+		t.Errorf("Scanner.Next() error = %+v, want line %v text %q", parseError, 2, "k2 = <<<END")
+	}
+}
+
+func TestScanner_Next_Escapes(t *testing.T) {
+	const source string = "k1 = \"  a \\\n  b  \"\nk2 = <<<END\n\\t\nEND\nk3 = a\\\\\nk4 = v4"
+	grammar := grammar_DEFAULT
+	grammar.Multiline = true
+	grammar.Escapes = true
+	scanner := NewScanner(strings.NewReader(source), Empty, grammar)
+	want := map[string]string{
+		"k1": "  a b  ",
+		"k2": `\t`,
+		"k3": `a\`,
+		"k4": "v4",
+	}
+	for range want {
+		got, err := scanner.Next()
+		if err != nil {
+			t.Fatalf("Scanner.Next() error = %v", err)
+		}
+		if got.Value != want[got.Key] {
+			t.Errorf("Scanner.Next() = %q, want %q", got.Value, want[got.Key])
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Errorf("Scanner.Next() error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestScanner_Next_EscapesInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		wantErr    error
+		wantColumn int
+	}{
+		{"invalid escape", "k1 = ab\\q", core.ErrEscapeInvalid, 8},
+		{"invalid escape quoted", "k1 =  \"ab\\q\"", core.ErrEscapeInvalid, 10},
+		{"quote not closed", "k1 = \"ab", core.ErrQuoteInvalid, 6},
+		{"invalid escape continued", "k1 = ab \\\n cd\\q", core.ErrEscapeInvalid, 6},
+		{"invalid escape before comment", "k1 = ab\\q # comment", core.ErrEscapeInvalid, 8},
+		{"quote not closed before comment", "k1 = \"ab # comment", core.ErrQuoteInvalid, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grammar := grammar_DEFAULT
+			grammar.Multiline = true
+			grammar.Escapes = true
+			grammar.InlineComments = true
+			_, err := NewScanner(strings.NewReader(tt.source), Empty, grammar).Next()
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Scanner.Next() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != 1 || parseError.Column != tt.wantColumn {
+				t.Errorf("Scanner.Next() error = %+v, want line %v column %v", parseError, 1, tt.wantColumn)
+			}
+		})
+	}
+}
+
+func TestScanner_Next_Sections(t *testing.T) {
+	const source string = "k1=v1\n[s1]\nk2=v2\n[]\nk3=v3"
+	grammar := grammar_DEFAULT
+	grammar.Sections = true
+	scanner := NewScanner(strings.NewReader(source), Empty, grammar)
+	want := []Record{
+		{Position: Position{Line: 1, Offset: 0}, Key: "k1", Value: "v1", Column: 1, Text: "k1=v1"},
+		{Position: Position{Line: 3, Offset: 11, Section: "s1"}, Key: "s1.k2", Value: "v2", Column: 1, Text: "k2=v2"},
+		{Position: Position{Line: 5, Offset: 20}, Key: "k3", Value: "v3", Column: 1, Text: "k3=v3"},
+	}
+	for _, wantRecord := range want {
+		got, err := scanner.Next()
+		if err != nil {
+			t.Fatalf("Scanner.Next() error = %v", err)
+		}
+		if got != wantRecord {
+			t.Errorf("Scanner.Next() = %+v, want %+v", got, wantRecord)
+		}
+	}
+
+	scanner = NewScanner(strings.NewReader(source[11:]), Empty, grammar)
+	scanner.Start(want[1].Position)
+	got, err := scanner.Next()
+	if err != nil {
+		t.Fatalf("Scanner.Next() error = %v", err)
+	}
+	if got != want[1] {
+		t.Errorf("Scanner.Next() = %+v, want %+v", got, want[1])
+	}
+}
+
+func TestScanner_Next_Include(t *testing.T) {
+	grammar := grammar_DEFAULT
+	grammar.Includes = fstest.MapFS{
+		"a":   &fstest.MapFile{Data: []byte("k2=v2\n@include b/c\n")},
+		"b/c": &fstest.MapFile{Data: []byte("\nk3=v3\n@include a")},
+	}
+	scanner := NewScanner(strings.NewReader("k1=v1\n@include a\nk4=v4"), "main", grammar)
+	want := []Record{
+		{Position: Position{Source: "main", Line: 1, Offset: 0}, Key: "k1", Value: "v1", Column: 1, Text: "k1=v1"},
+		{Position: Position{Source: "a", Line: 1, Offset: 0}, Key: "k2", Value: "v2", Column: 1, Text: "k2=v2"},
+		{Position: Position{Source: "b/c", Line: 2, Offset: 1}, Key: "k3", Value: "v3", Column: 1, Text: "k3=v3"},
+	}
+	for _, wantRecord := range want {
+		got, err := scanner.Next()
+		if err != nil {
+			t.Fatalf("Scanner.Next() error = %v", err)
+		}
+		if got != wantRecord {
+			t.Errorf("Scanner.Next() = %+v, want %+v", got, wantRecord)
+		}
+	}
+	_, err := scanner.Next()
+	var parseError *core.ParseError
+	if !errors.As(err, &parseError) || !errors.Is(err, core.ErrIncludeCycle) {
+		t.Fatalf("Scanner.Next() error = %v, want %v", err, core.ErrIncludeCycle)
+	}
+	if parseError.Source != "b/c" || parseError.Line != 3 {
+		t.Errorf("Scanner.Next() error = %+v, want source %q line %v", parseError, "b/c", 3)
+	}
+	got, err := scanner.Next()
+	if err != nil || got.Key != "k4" || got.Source != "main" || got.Line != 3 {
+		t.Errorf("Scanner.Next() = %+v, %v, want %q of %q at line %v", got, err, "k4", "main", 3)
+	}
+}
+
+func TestScanner_Start(t *testing.T) {
+	const source string = "k1=v1\nk2=v2\nk3=v3"
+	scanner := NewScanner(strings.NewReader(source[6:]), Empty, grammar_DEFAULT)
+	scanner.Start(Position{Line: 2, Offset: 6})
+	got, err := scanner.Next()
+	if err != nil {
+		t.Fatalf("Scanner.Next() error = %v", err)
+	}
+	if want := (Position{Line: 2, Offset: 6}); got.Position != want || got.Key != "k2" {
+		t.Errorf("Scanner.Next() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDuplication(t *testing.T) {
+	record := Record{Position: Position{Line: 5}, Key: "k2", Column: 2, Text: " k2=v22"}
+	err := Duplication(record, Position{Line: 2})
+	if !errors.Is(err, core.ErrNameDuplicated) {
+		t.Errorf("Duplication() error = %v, want %v", err, core.ErrNameDuplicated)
+	}
+	const want string = "line 5, column 2: duplicated name found, name 'k2' is first defined at line 2"
+	if err.Error() != want {
+		t.Errorf("Duplication() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	const source string = "k1=v1\n=v2\nk1=v11\nhello\nk1=v111"
+	faults, err := Check(NewScanner(strings.NewReader(source), Empty, grammar_DEFAULT))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	want := []struct {
+		line int
+		err  error
+	}{
+		{2, core.ErrNameNotPresent},
+		{3, core.ErrNameDuplicated},
+		{4, core.ErrSeparatorNotPresent},
+		{5, core.ErrNameDuplicated},
+	}
+	if len(faults) != len(want) {
+		t.Fatalf("Check() got %d errors, want %d", len(faults), len(want))
+	}
+	for index, fault := range faults {
+		if fault.Line != want[index].line || !errors.Is(fault, want[index].err) {
+			t.Errorf("Check() error[%d] = %v, want line %d %v", index, fault, want[index].line, want[index].err)
+		}
+	}
+	if _, err := Check(NewScanner(iotest.ErrReader(io.ErrUnexpectedEOF), Empty, grammar_DEFAULT)); err != io.ErrUnexpectedEOF {
+		t.Errorf("Check() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
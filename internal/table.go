@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Cell a record of a cell of table, with index of its language column
+type Cell struct {
+	Record
+	Language int
+}
+
+// ParseTable read cells of a CSV table of names and languages like "key,EN,FA", separated by comma such as ',' or '\t'.
+// The header row is the name column and language columns, names are in the first column and empty cells are skipped.
+// Cells are in order of rows, and columns of each row. Errors are "*core.ParseError" with line and column of the field.
+// Quotes of TSV are lazy, so a quote in a field which is not quoted is kept, as spreadsheets export it.
+func ParseTable(data []byte, source string, comma rune) ([]string, []Cell, error) {
+	data = DecodeUnicode(data)
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil, core.ErrWordsEmpty
+	}
+
+	var reader = csv.NewReader(bytes.NewReader(data))
+	reader.Comma = comma
+	reader.LazyQuotes = comma == '\t'
+
+	var fault = func(line int, column int, err error) error {
+		var record = RecordAt(data, source, lineOffset(data, line))
+		if column > 0 {
+			record.Column = Column(record.Text, column-1)
+		}
+		return &core.ParseError{Source: source, Line: record.Line, Column: record.Column, Text: record.Text, Err: err}
+	}
+	var read = func() ([]string, error) {
+		row, err := reader.Read()
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) {
+			return nil, fault(parseError.Line, parseError.Column, parseError.Err)
+		}
+		return row, err
+	}
+
+	header, err := read()
+	if err != nil {
+		return nil, nil, err
+	}
+	var languages = make([]string, 0, len(header)-1)
+	for column, language := range header[1:] {
+		language = strings.TrimSpace(language)
+		for _, previous := range languages {
+			if language == previous {
+				language = Empty
+			}
+		}
+		if language == Empty || strings.ContainsAny(language, "\r\n") {
+			line, position := reader.FieldPos(column + 1)
+			return nil, nil, fault(line, position, core.ErrTableInvalid)
+		}
+		languages = append(languages, language)
+	}
+	if len(languages) == 0 {
+		return nil, nil, fault(1, 0, core.ErrTableInvalid)
+	}
+
+	var (
+		names map[string]Position = make(map[string]Position)
+		cells []Cell
+	)
+	for {
+		row, err := read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var cell = func(column int) Record {
+			line, position := reader.FieldPos(column)
+			var record = RecordAt(data, source, lineOffset(data, line))
+			record.Column = Column(record.Text, position-1)
+			return record
+		}
+
+		var name = cell(0)
+		var ok bool
+		if name.Key, ok = ValidationName(row[0]); !ok {
+			return nil, nil, &core.ParseError{Source: source, Line: name.Line, Column: name.Column, Text: name.Text, Err: core.ErrNameNotPresent}
+		}
+		if previous, found := names[name.Key]; found {
+			return nil, nil, Duplication(name, previous)
+		}
+		names[name.Key] = name.Position
+
+		for column, value := range row[1:] {
+			if value == Empty {
+				continue
+			}
+			var record = cell(column + 1)
+			record.Key, record.Value = name.Key, value
+			cells = append(cells, Cell{Record: record, Language: column})
+		}
+	}
+	return languages, cells, nil
+}
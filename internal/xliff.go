@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"encoding/xml"
+	"strings"
+	"unicode"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Pre-defined elements and attributes of XLIFF 1.2 and 2.0 documents
+const (
+	xliffRoot             string = "xliff"
+	xliffFile             string = "file"
+	xliffBody             string = "body"
+	xliffGroup            string = "group"
+	xliffTransUnit        string = "trans-unit"
+	xliffUnit             string = "unit"
+	xliffSegment          string = "segment"
+	xliffIgnorable        string = "ignorable"
+	xliffSource           string = "source"
+	xliffTarget           string = "target"
+	xliffTargetLanguage   string = "target-language"
+	xliffTargetLanguage20 string = "trgLang"
+	xliffResourceName     string = "resname"
+	xliffName             string = "name"
+	xliffID               string = "id"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// ParseXLIFF read records of XLIFF 1.2 and 2.0 documents, of all files and groups.
+// The name is `resname` of `<trans-unit>` or `name` of `<unit>` if present, else `id`.
+// If the document has a target language, the value is the target text and untranslated units are skipped,
+// else the value is the source text. Inline elements such as `<ph>` are kept as markup.
+func ParseXLIFF(data []byte, source string) ([]Record, error) {
+	data = DecodeUnicode(data)
+	var reader = newXMLReader(data, source)
+	root, err := reader.root(xliffRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var container func(translated bool) error
+	container = func(translated bool) error {
+		return reader.children(func(element xml.StartElement) error {
+			switch element.Name.Local {
+			case xliffFile:
+				language, _ := attribute(element, xliffTargetLanguage)
+				return container(translated || language != Empty)
+			case xliffBody, xliffGroup:
+				return container(translated)
+			case xliffTransUnit, xliffUnit:
+				return reader.unit(element, translated)
+			default:
+				return reader.skip()
+			}
+		})
+	}
+	language, _ := attribute(root, xliffTargetLanguage20)
+	if err := container(language != Empty); err != nil {
+		return nil, err
+	}
+	return reader.records, nil
+}
+
+// unit read a translation unit of XLIFF, texts of all segments are joined
+func (r *xmlReader) unit(element xml.StartElement, translated bool) error {
+	var record = r.record(Empty, Empty)
+	name, found := attribute(element, xliffResourceName)
+	if !found {
+		name, found = attribute(element, xliffName)
+	}
+	if !found {
+		name, _ = attribute(element, xliffID)
+	}
+	if record.Key = strings.TrimSpace(name); record.Key == Empty {
+		return r.fault(core.ErrNameNotPresent)
+	}
+
+	var source, target strings.Builder
+	var content func() error
+	content = func() error {
+		return r.children(func(child xml.StartElement) error {
+			var builder *strings.Builder
+			switch child.Name.Local {
+			case xliffSegment, xliffIgnorable:
+				return content()
+			case xliffSource:
+				builder = &source
+			case xliffTarget:
+				builder = &target
+			default:
+				return r.skip()
+			}
+			text, err := r.text(nil)
+			builder.WriteString(text)
+			return err
+		})
+	}
+	if err := content(); err != nil {
+		return err
+	}
+
+	record.Value = source.String()
+	if translated {
+		if record.Value = target.String(); record.Value == Empty {
+			return nil
+		}
+	}
+	return r.add(record)
+}
+
+// ValidToken return `true` if text is a valid XML name token (NMTOKEN), such as ids of XLIFF units
+func ValidToken(text string) bool {
+	if text == Empty {
+		return false
+	}
+	for _, character := range text {
+		if !unicode.IsLetter(character) && !unicode.IsDigit(character) && !strings.ContainsRune("._-:", character) {
+			return false
+		}
+	}
+	return true
+}
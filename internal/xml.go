@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// xmlReader the state of reading records of a XML resource
+type xmlReader struct {
+	data    []byte
+	source  string
+	decoder *xml.Decoder
+	offset  int64   // Offset of the last token
+	tags    [][]int // Ranges of tags of markup in the last text
+	names   map[string]Position
+	records []Record
+}
+
+// newXMLReader create a reader of records of XML data, data must be UTF-8 or decoded by "DecodeUnicode"
+// so declarations of UTF-16 encoding are accepted
+func newXMLReader(data []byte, source string) *xmlReader {
+	var decoder = xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		if strings.HasPrefix(strings.ToLower(label), "utf-16") {
+			return input, nil
+		}
+		return nil, core.ErrXMLInvalid
+	}
+	return &xmlReader{
+		data:    data,
+		source:  source,
+		decoder: decoder,
+		names:   make(map[string]Position),
+	}
+}
+
+// token read the next token, end of data is an error
+func (r *xmlReader) token() (xml.Token, error) {
+	r.offset = r.decoder.InputOffset()
+	token, err := r.decoder.Token()
+	if err != nil {
+		return nil, r.fault(err)
+	}
+	return token, nil
+}
+
+// root read the root element and check its local name
+func (r *xmlReader) root(name string) (xml.StartElement, error) {
+	if len(bytes.TrimSpace(r.data)) == 0 {
+		return xml.StartElement{}, core.ErrWordsEmpty
+	}
+	for {
+		token, err := r.token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		if element, ok := token.(xml.StartElement); ok {
+			if element.Name.Local != name {
+				return xml.StartElement{}, r.fault(core.ErrXMLInvalid)
+			}
+			return element, nil
+		}
+	}
+}
+
+// children call visit for each child element of current element until its end, visit must read the whole child
+func (r *xmlReader) children(visit func(element xml.StartElement) error) error {
+	for {
+		token, err := r.token()
+		if err != nil {
+			return err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if err := visit(token); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// skip read and ignore the rest of current element
+func (r *xmlReader) skip() error {
+	if err := r.decoder.Skip(); err != nil {
+		return r.fault(err)
+	}
+	return nil
+}
+
+// text read the content of current element until its end, child elements are kept as markup like `<b>bold</b>`,
+// except elements of which strip return `true` which only their content is kept. Ranges of tags are kept by tags field.
+func (r *xmlReader) text(strip func(xml.Name) bool) (string, error) {
+	var (
+		builder strings.Builder
+		depth   int
+	)
+	r.tags = nil
+	for {
+		token, err := r.token()
+		if err != nil {
+			return Empty, err
+		}
+		switch token := token.(type) {
+		case xml.CharData:
+			builder.Write(token)
+		case xml.StartElement:
+			depth++
+			if strip == nil || !strip(token.Name) {
+				var start = builder.Len()
+				builder.WriteString("<" + token.Name.Local)
+				for _, attribute := range token.Attr {
+					builder.WriteString(" " + attribute.Name.Local + "=" + QuoteXML(attribute.Value))
+				}
+				builder.WriteString(">")
+				r.tags = append(r.tags, []int{start, builder.Len()})
+			}
+		case xml.EndElement:
+			if depth == 0 {
+				return builder.String(), nil
+			}
+			depth--
+			if strip == nil || !strip(token.Name) {
+				var start = builder.Len()
+				builder.WriteString("</" + token.Name.Local + ">")
+				r.tags = append(r.tags, []int{start, builder.Len()})
+			}
+		}
+	}
+}
+
+// add append record, return error if name of record is duplicated
+func (r *xmlReader) add(record Record) error {
+	if previous, found := r.names[record.Key]; found {
+		return Duplication(record, previous)
+	}
+	r.names[record.Key] = record.Position
+	r.records = append(r.records, record)
+	return nil
+}
+
+// record create a record located at the last token
+func (r *xmlReader) record(key string, value string) Record {
+	var record = RecordAt(r.data, r.source, r.offset)
+	record.Key, record.Value = key, value
+	return record
+}
+
+// fault create error of reason located at the last token, line of syntax errors is used for them
+func (r *xmlReader) fault(reason error) error {
+	var offset = r.offset
+	var syntaxError *xml.SyntaxError
+	if errors.As(reason, &syntaxError) {
+		offset = lineOffset(r.data, syntaxError.Line)
+	}
+	if reason == io.EOF {
+		reason = io.ErrUnexpectedEOF
+	}
+	var record = RecordAt(r.data, r.source, offset)
+	return &core.ParseError{Source: r.source, Line: record.Line, Column: record.Column, Text: record.Text, Err: reason}
+}
+
+// lineOffset return offset of beginning of line in data, starting from 1
+func lineOffset(data []byte, line int) int64 {
+	var offset int
+	for ; line > 1; line-- {
+		index := bytes.IndexByte(data[offset:], NewLineByte)
+		if index < 0 {
+			break
+		}
+		offset += index + 1
+	}
+	return int64(offset)
+}
+
+// attribute return value of attribute of element by local name, and `true` if found
+func attribute(element xml.StartElement, name string) (string, bool) {
+	for _, attribute := range element.Attr {
+		if attribute.Name.Local == name {
+			return attribute.Value, true
+		}
+	}
+	return Empty, false
+}
+
+// EscapeXML escape text to be used as content of a XML element, only "&", "<" and ">" are escaped
+func EscapeXML(text string) string {
+	return xmlEscaper.Replace(text)
+}
+
+// xmlEscaper the replacer of special characters of content of XML elements
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// QuoteXML escape text and enclose it in double quotes to be used as value of a XML attribute
+func QuoteXML(text string) string {
+	var buffer bytes.Buffer
+	_ = xml.EscapeText(&buffer, []byte(text))
+	return `"` + buffer.String() + `"`
+}
@@ -0,0 +1,158 @@
+package gowords
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromJSON create a new instance of WordsCollection by reading a JSON object from reader.
+// Names of nested objects are joined by dot, such as `{"errors": {"notfound": "..."}}` to "errors.notfound".
+// Values can be strings, numbers, booleans and null as empty string.
+func NewWordsCollectionFromJSON(reader io.Reader) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readSource(reader, internal.FlattenJSON) })
+}
+
+// NewWordsRepositoryFromJSON create a new instance of WordsRepository by reading a JSON object from reader,
+// same as "NewWordsCollectionFromJSON"
+func NewWordsRepositoryFromJSON(reader io.Reader) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readSource(reader, internal.FlattenJSON) })
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WriteJSON write names and values of words as a flat JSON object in order of source,
+// words must be Enumerable.
+func WriteJSON(writer io.Writer, words Words) error {
+	var (
+		buffer bytes.Buffer
+		count  int
+	)
+	buffer.WriteString("{")
+	err := enumerate(words, func(name string, value string) bool {
+		if count > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString("\n" + jsonIndent)
+		buffer.WriteString(quoteJSON(name) + ": " + quoteJSON(value))
+		count++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		buffer.WriteString("\n")
+	}
+	buffer.WriteString("}\n")
+
+	_, err = buffer.WriteTo(writer)
+	return err
+}
+
+// WriteJSONNested write names and values of words as a JSON object in order of source,
+// names are split by dot to nested objects, such as "errors.notfound" to `{"errors": {"notfound": "..."}}`.
+// Return "core.ErrJSONConflict" if a name is both a value and parent of other names, such as "errors" and "errors.notfound".
+// Words must be Enumerable.
+func WriteJSONNested(writer io.Writer, words Words) error {
+	var (
+		root  *jsonNode = newJSONNode()
+		fault error
+	)
+	err := enumerate(words, func(name string, value string) bool {
+		fault = root.insert(strings.Split(name, internal.SectionDelimiter), value)
+		return fault == nil
+	})
+	if err != nil {
+		return err
+	}
+	if fault != nil {
+		return fault
+	}
+
+	var buffer bytes.Buffer
+	root.write(&buffer, internal.Empty)
+	buffer.WriteString("\n")
+
+	_, err = buffer.WriteTo(writer)
+	return err
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Indent of each level of JSON objects
+const jsonIndent string = "  "
+
+// jsonNode an object of nested JSON, keeping order of names
+type jsonNode struct {
+	names    []string
+	values   map[string]string
+	children map[string]*jsonNode
+}
+
+// newJSONNode create a new instance of jsonNode
+func newJSONNode() *jsonNode {
+	return &jsonNode{
+		values:   make(map[string]string),
+		children: make(map[string]*jsonNode),
+	}
+}
+
+// insert add value to node by path of names
+func (n *jsonNode) insert(path []string, value string) error {
+	var name = path[0]
+	if _, found := n.values[name]; found {
+		return core.ErrJSONConflict
+	}
+	if len(path) == 1 {
+		if _, found := n.children[name]; found {
+			return core.ErrJSONConflict
+		}
+		n.values[name] = value
+		n.names = append(n.names, name)
+		return nil
+	}
+	child, found := n.children[name]
+	if !found {
+		child = newJSONNode()
+		n.children[name] = child
+		n.names = append(n.names, name)
+	}
+	return child.insert(path[1:], value)
+}
+
+// write write node as a JSON object to buffer, indent is the indent of the node
+func (n *jsonNode) write(buffer *bytes.Buffer, indent string) {
+	if len(n.names) == 0 {
+		buffer.WriteString("{}")
+		return
+	}
+	buffer.WriteString("{")
+	for index, name := range n.names {
+		if index > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString("\n" + indent + jsonIndent + quoteJSON(name) + ": ")
+		if child, found := n.children[name]; found {
+			child.write(buffer, indent+jsonIndent)
+		} else {
+			buffer.WriteString(quoteJSON(n.values[name]))
+		}
+	}
+	buffer.WriteString("\n" + indent + "}")
+}
+
+// quoteJSON encode text as a JSON string without escaping HTML characters
+func quoteJSON(text string) string {
+	var buffer bytes.Buffer
+	var encoder = json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(text)
+	return strings.TrimSuffix(buffer.String(), internal.NewLine)
+}
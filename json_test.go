@@ -0,0 +1,171 @@
+package gowords_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromJSON(t *testing.T) {
+	want := map[string]string{
+		"title":                "MyApp",
+		"errors.notfound":      "Not found",
+		"errors.http.notfound": "HTTP not found",
+		"size":                 "100",
+		"enabled":              "true",
+		"empty":                "",
+		"a=b":                  "equal\nsign",
+		"html":                 "<b>&</b>",
+	}
+	open := func() io.Reader {
+		file, err := os.Open(path.Join(path_WORDS, "words.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { file.Close() })
+		return file
+	}
+	wCollection, err := NewWordsCollectionFromJSON(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromJSON(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}, want)
+	if value, found := wCollection.Find("errors"); found {
+		t.Errorf("WordsCollection.Find() = %q, %v, want %q, %v", value, found, "", false)
+	}
+}
+
+func TestNewWordsFromJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		wantErr    error
+		wantLine   int
+		wantColumn int
+	}{
+		{"array value", "{\n  \"k1\": [\"v1\"]\n}", core.ErrJSONInvalid, 2, 3},
+		{"array source", "[\"v1\"]", core.ErrJSONInvalid, 1, 1},
+		{"string source", "\"v1\"", core.ErrJSONInvalid, 1, 1},
+		{"trailing data", "{\"k1\": \"v1\"} {}", core.ErrJSONInvalid, 1, 1},
+		{"duplicated name", "{\n  \"a\": {\"b\": \"v1\"},\n  \"a.b\": \"v2\"\n}", core.ErrNameDuplicated, 3, 3},
+		{"duplicated same name", "{\n  \"k1\": \"v1\",\n  \"k1\": \"v2\"\n}", core.ErrNameDuplicated, 3, 3},
+		{"empty name", "{\n  \" \": \"v1\"\n}", core.ErrNameNotPresent, 2, 3},
+		{"syntax", "{\n  \"k1\": \"v1\",\n  \"k2\" \"v2\"\n}", nil, 3, 3},
+		{"unexpected end", "{\n  \"k1\": \"v1\"", nil, 2, 3},
+		{"carriage returns", "{\r  \"k1\": \"v1\",\r\n  \"k2\": [\"v2\"]\r}", core.ErrJSONInvalid, 3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromJSON(strings.NewReader(tt.source))
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("NewWordsCollectionFromJSON() error = %v, want *core.ParseError", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWordsCollectionFromJSON() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine || parseError.Column != tt.wantColumn {
+				t.Errorf("NewWordsCollectionFromJSON() error = %+v, want line %v column %v", parseError, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+
+	if _, err := NewWordsCollectionFromJSON(nil); !errors.Is(err, core.ErrReaderNil) {
+		t.Errorf("NewWordsCollectionFromJSON() error = %v, want %v", err, core.ErrReaderNil)
+	}
+	if _, err := NewWordsRepositoryFromJSON(strings.NewReader("  ")); !errors.Is(err, core.ErrWordsEmpty) {
+		t.Errorf("NewWordsRepositoryFromJSON() error = %v, want %v", err, core.ErrWordsEmpty)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	const source string = "title = MyApp\nerrors.notfound = Not found\nhtml = <b>\"&\"</b>"
+	const want string = `{
+  "title": "MyApp",
+  "errors.notfound": "Not found",
+  "html": "<b>\"&\"</b>"
+}
+`
+	for kind, words := range storagesOf(t, source) {
+		var buffer bytes.Buffer
+		if err := WriteJSON(&buffer, words); err != nil {
+			t.Fatalf("%s WriteJSON() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WriteJSON() = %v, want %v", kind, buffer.String(), want)
+		}
+		if !json.Valid(buffer.Bytes()) {
+			t.Errorf("%s WriteJSON() is not valid JSON", kind)
+		}
+	}
+}
+
+func TestWriteJSONNested(t *testing.T) {
+	const source string = "title = MyApp\nerrors.notfound = Not found\nversion = 1.0\nerrors.http.notfound = HTTP not found"
+	const want string = `{
+  "title": "MyApp",
+  "errors": {
+    "notfound": "Not found",
+    "http": {
+      "notfound": "HTTP not found"
+    }
+  },
+  "version": "1.0"
+}
+`
+	for kind, words := range storagesOf(t, source) {
+		var buffer bytes.Buffer
+		if err := WriteJSONNested(&buffer, words); err != nil {
+			t.Fatalf("%s WriteJSONNested() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WriteJSONNested() = %v, want %v", kind, buffer.String(), want)
+		}
+		w, err := NewWordsCollectionFromJSON(&buffer)
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromJSON() error = %v", kind, err)
+		}
+		if value := w.Get("errors.http.notfound"); value != "HTTP not found" {
+			t.Errorf("%s Get() = %q, want %q", kind, value, "HTTP not found")
+		}
+	}
+}
+
+func TestWriteJSONNested_Conflict(t *testing.T) {
+	for _, source := range []string{"errors = Errors\nerrors.notfound = Not found", "errors.notfound = Not found\nerrors = Errors"} {
+		for kind, words := range storagesOf(t, source) {
+			if err := WriteJSONNested(io.Discard, words); !errors.Is(err, core.ErrJSONConflict) {
+				t.Errorf("%s WriteJSONNested() error = %v, want %v", kind, err, core.ErrJSONConflict)
+			}
+		}
+	}
+}
+
+func TestWriteJSON_NotEnumerable(t *testing.T) {
+	wSuffix, err := NewWithSuffix(wordsMap{"k1_EN": "v1"}, "_EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteJSON(io.Discard, wSuffix); !errors.Is(err, core.ErrWordsNotEnumerable) {
+		t.Errorf("WriteJSON() error = %v, want %v", err, core.ErrWordsNotEnumerable)
+	}
+	if err := WriteJSONNested(io.Discard, wSuffix); !errors.Is(err, core.ErrWordsNotEnumerable) {
+		t.Errorf("WriteJSONNested() error = %v, want %v", err, core.ErrWordsNotEnumerable)
+	}
+}
@@ -0,0 +1,182 @@
+package gowords
+
+import (
+	"io/fs"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Option an optional behaviour of parsing source, to pass to instantiation functions
+type Option func(*configuration)
+
+// configuration the rules of parsing source, prepared from delimiters and options
+type configuration struct {
+	grammar internal.Grammar
+	storage core.Storage
+	fold    core.Fold
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WithMultiline enable multi-line values.
+// A value ending with backslash `\` is continued in the next line, leading whitespace of the next line is removed.
+// A value of heredoc block like `<<<END` is the following lines as is, until a line of the closing delimiter `END`.
+func WithMultiline() Option {
+	return func(c *configuration) {
+		c.grammar.Multiline = true
+	}
+}
+
+// WithEscapes enable escape sequences and quoted values.
+// Sequences "\n", "\t", "\r", "\uXXXX" and backslash followed by a character which is not letter or digit
+// such as "\\" and "\=" are decoded in names and values, an escaped separator is part of the name.
+// A value enclosed in double quotes like `"  padded  "` keeps its leading and trailing whitespace.
+// Use "EncodeLine" to create lines of source with escapes.
+func WithEscapes() Option {
+	return func(c *configuration) {
+		c.grammar.Escapes = true
+	}
+}
+
+// WithInlineComments enable trailing comments after values like `timeout = 30  # seconds`.
+// A comment starts at the comment character in beginning of value or after whitespace.
+// With "WithEscapes" option, an escaped comment character or a comment character in quoted value is part of value.
+func WithInlineComments() Option {
+	return func(c *configuration) {
+		c.grammar.InlineComments = true
+	}
+}
+
+// WithSections enable INI-style section headers like `[errors]`, which prefix names of following lines
+// by the name of section and a dot, such as `errors.notfound`. An empty header `[]` ends the current section.
+func WithSections() Option {
+	return func(c *configuration) {
+		c.grammar.Sections = true
+	}
+}
+
+// WithIncludes enable include directives like `@include path/to/file`, which read records of the named file of fsys
+// in place of the directive. Paths are relative to the root of fsys, cyclic includes are reported by "core.ErrIncludeCycle".
+// Errors of included files are reported by name of the file, and names must be unique across all files.
+func WithIncludes(fsys fs.FS) Option {
+	return func(c *configuration) {
+		c.grammar.Includes = fsys
+	}
+}
+
+// WithSeparator set separator of names and values to a string of one or more characters such as "=>" or "::",
+// instead of the separator character of instantiation functions, an empty string keeps the separator character.
+// It must be punctuation or symbol characters except backslash and double quote, else "core.ErrDelimiterInvalid" is returned.
+func WithSeparator(separator string) Option {
+	return func(c *configuration) {
+		c.grammar.Separator = separator
+	}
+}
+
+// WithComments set prefixes of comment lines to strings of one or more characters such as "#" and "//",
+// instead of the comment character of instantiation functions. A line starting with any of prefixes is a comment.
+// They must be punctuation or symbol characters except backslash and double quote, else "core.ErrDelimiterInvalid" is returned.
+func WithComments(prefixes ...string) Option {
+	return func(c *configuration) {
+		c.grammar.Comments = append([]string{}, prefixes...)
+	}
+}
+
+// WithTrim set policy of trimming whitespace around values, such as "core.TrimLeading" to keep trailing whitespace.
+// Names are always trimmed, and values of continuation lines, quoted values and values with inline comments
+// are trimmed by their own rules.
+func WithTrim(policy core.Trim) Option {
+	return func(c *configuration) {
+		c.grammar.Trim = policy
+	}
+}
+
+// WithDuplicates set policy of duplicated names, such as "core.DuplicatesLast" to keep the last value of a name.
+// By default duplicated names are errors. The kept value stays in place of the first occurrence of name in order of source.
+func WithDuplicates(policy core.Duplicates) Option {
+	return func(c *configuration) {
+		c.grammar.Duplicates = policy
+	}
+}
+
+// WithOverrides set a function to report each occurrence of a duplicated name which is resolved by policy of "WithDuplicates"
+// option instead of error, with the lines of the occurrence and the previously kept occurrence, such as an override file
+// included after defaults. It is called in order of source on instantiation and validation, and by "CheckError" of WordsFile.
+func WithOverrides(report func(override core.Override)) Option {
+	return func(c *configuration) {
+		c.grammar.Overrides = report
+	}
+}
+
+// WithNormalizer set a function to normalize names, such as "strings.ToLower".
+// Names of source are normalized on parsing, so duplication is checked on normalized names,
+// and names are normalized on each search after trimming. A name normalized to empty string is an error of source.
+func WithNormalizer(normalize func(string) string) Option {
+	return func(c *configuration) {
+		c.grammar.Normalize = normalize
+	}
+}
+
+// WithFolding enable folding names by rules, such as "core.FoldCase | core.FoldSpace" to match "App  Name" by "app name".
+// Names of source are folded on parsing, so duplication is checked on folded names, and names are folded on each search.
+// Folding is applied after the function of "WithNormalizer" option, which can be used for Unicode normalization
+// such as "norm.NFC.String" of "golang.org/x/text/unicode/norm" package.
+func WithFolding(fold core.Fold) Option {
+	return func(c *configuration) {
+		c.fold = fold
+	}
+}
+
+// WithStorage set kind of storage created by "NewWords", such as "core.StorageRepository".
+// Other instantiation functions ignore it.
+func WithStorage(kind core.Storage) Option {
+	return func(c *configuration) {
+		c.storage = kind
+	}
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// configure validate delimiters and apply options, return prepared configuration.
+// Separator and comment characters are replaced by delimiters of "WithSeparator" and "WithComments" options.
+func configure(separator rune, comment rune, options []Option) (configuration, error) {
+	var config configuration
+	for _, option := range options {
+		if option != nil {
+			option(&config)
+		}
+	}
+
+	var grammar = &config.grammar
+	grammar.Normalize = internal.Folding(grammar.Normalize, config.fold)
+	if grammar.Separator == internal.Empty && grammar.Comments == nil {
+		err := internal.ValidationDelimiters(string(separator), string(comment))
+		if err != nil {
+			return configuration{}, err
+		}
+		grammar.Separator = string(separator)
+		grammar.Comments = []string{string(comment)}
+		return config, nil
+	}
+
+	if grammar.Separator == internal.Empty {
+		if !internal.RegexSeparator.MatchString(string(separator)) {
+			return configuration{}, core.ErrSeparatorIsInvalid
+		}
+		grammar.Separator = string(separator)
+	}
+	if grammar.Comments == nil {
+		if !internal.RegexComments.MatchString(string(comment)) {
+			return configuration{}, core.ErrCommentIsInvalid
+		}
+		grammar.Comments = []string{string(comment)}
+	}
+	err := internal.ValidationDelimiterList(grammar.Separator, grammar.Comments)
+	if err != nil {
+		return configuration{}, err
+	}
+	return config, nil
+}
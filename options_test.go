@@ -0,0 +1,549 @@
+package gowords_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestWithMultiline(t *testing.T) {
+	storages := storagesWith(t, "multiline", WithMultiline())
+	findAll(t, storages, map[string]string{
+		"k1": "first line second line",
+		"k2": "Dear user,\n\n  indented line",
+		"k3": "v3",
+		"k4": "a b c",
+		"k5": "",
+		"k6": "<<< END",
+		"k7": "v7",
+	})
+}
+
+func TestWithMultiline_Disabled(t *testing.T) {
+	source, err := os.ReadFile(path.Join(path_WORDS, "multiline"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWordsCollection(string(source), core.Separator, core.Comment); !errors.Is(err, core.ErrSeparatorNotPresent) {
+		t.Errorf("NewWordsCollection() error = %v, want %v", err, core.ErrSeparatorNotPresent)
+	}
+}
+
+func TestWithMultiline_Unclosed(t *testing.T) {
+	source, err := os.ReadFile(path.Join(path_WORDS, "multiline_unclosed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewWordsRepository(string(source), core.Separator, core.Comment, WithMultiline())
+	var parseError *core.ParseError
+	if !errors.As(err, &parseError) || !errors.Is(err, core.ErrBlockNotClosed) {
+		t.Fatalf("NewWordsRepository() error = %v, want %v", err, core.ErrBlockNotClosed)
+	}
+	if parseError.Line != 2 || parseError.Column != 6 {
+		t.Errorf("NewWordsRepository() error = %+v, want line %v column %v", parseError, 2, 6)
+	}
+}
+
+func TestWithEscapes(t *testing.T) {
+	storages := storagesWith(t, "escapes", WithEscapes())
+	findAll(t, storages, map[string]string{
+		"k1":   "  padded  ",
+		"k2":   "line 1\nline 2",
+		"k3":   "a\tb",
+		"a=b":  "v4",
+		"k5":   "متن",
+		"k6":   `back\slash`,
+		"k7":   `say "hi"`,
+		"k8":   `"not quoted`,
+		"# k9": "v9",
+		"k10":  "",
+		"k11":  "plain value",
+	})
+	for kind, words := range storages {
+		if value, found := words.Find("a"); found {
+			t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, "a", value, found, "", false)
+		}
+	}
+}
+
+func TestWithInlineComments(t *testing.T) {
+	storages := storagesWith(t, "inline_comments", WithInlineComments(), WithEscapes(), WithMultiline())
+	findAll(t, storages, map[string]string{
+		"timeout": "30",
+		"retries": "3",
+		"color":   "red#blue",
+		"empty":   "",
+		"url":     "http://example.com/#top",
+		"channel": "#general",
+		"k7":      "a b",
+		"k8":      "c",
+	})
+}
+
+func TestWithInlineComments_Disabled(t *testing.T) {
+	w, err := NewWordsCollection("timeout = 30  # seconds", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := w.Get("timeout"); value != "30  # seconds" {
+		t.Errorf("WordsCollection.Get() = %q, want %q", value, "30  # seconds")
+	}
+}
+
+func TestWithSections(t *testing.T) {
+	storages := storagesWith(t, "sections", WithSections(), WithInlineComments())
+	findAll(t, storages, map[string]string{
+		"title":                "MyApp",
+		"errors.notfound":      "Not found",
+		"errors.internal":      "Internal error",
+		"errors.http.notfound": "HTTP not found",
+		"version":              "1.0",
+		"messages.hello":       "Hello",
+	})
+	for kind, words := range storages {
+		if value, found := words.Find("notfound"); found {
+			t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, "notfound", value, found, "", false)
+		}
+	}
+}
+
+func TestWithSections_Separator(t *testing.T) {
+	const source string = "[errors]\nnotfound . Not found"
+	wRepository, err := NewWordsRepository(source, '.', core.Comment, WithSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wCollection, err := NewWordsCollection(source, '.', core.Comment, WithSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for kind, words := range map[string]Words{"WordsRepository": wRepository, "WordsCollection": wCollection} {
+		if value, found := words.Find("errors.notfound"); value != "Not found" || !found {
+			t.Errorf("%s.Find() = %q, %v, want %q, %v", kind, value, found, "Not found", true)
+		}
+		if value, found := words.Find("errors"); found {
+			t.Errorf("%s.Find() = %q, %v, want %q, %v", kind, value, found, "", false)
+		}
+	}
+}
+
+func TestWithSections_Duplicate(t *testing.T) {
+	source, err := os.ReadFile(path.Join(path_WORDS, "sections_duplicate"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path.Join(path_WORDS, "sections_duplicate"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	wFile, err := NewWordsFile(file, core.Separator, core.Comment, WithSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, errRepository := NewWordsRepository(string(source), core.Separator, core.Comment, WithSections())
+	_, errCollection := NewWordsCollection(string(source), core.Separator, core.Comment, WithSections())
+	for kind, err := range map[string]error{"WordsRepository": errRepository, "WordsCollection": errCollection, "WordsFile": wFile.CheckError()} {
+		var parseError *core.ParseError
+		if !errors.As(err, &parseError) || !errors.Is(err, core.ErrNameDuplicated) {
+			t.Fatalf("%s error = %v, want %v", kind, err, core.ErrNameDuplicated)
+		}
+		if parseError.Name != "errors.notfound" || parseError.Line != 4 || parseError.Previous != 2 {
+			t.Errorf("%s error = %+v, want name %q line %v previous %v", kind, parseError, "errors.notfound", 4, 2)
+		}
+	}
+	if _, err := NewWordsCollection(string(source), core.Separator, core.Comment); !errors.Is(err, core.ErrSeparatorNotPresent) {
+		t.Errorf("NewWordsCollection() error = %v, want %v", err, core.ErrSeparatorNotPresent)
+	}
+}
+
+func TestWithIncludes(t *testing.T) {
+	fsys := os.DirFS(path.Join(path_WORDS, "include"))
+	storages := storagesWith(t, "include/main", WithIncludes(fsys))
+	findAll(t, storages, map[string]string{
+		"title":    "MyApp",
+		"notfound": "Not found",
+		"internal": "Internal error",
+		"hello":    "Hello",
+		"bye":      "Goodbye",
+		"version":  "1.0",
+	})
+}
+
+func TestWithIncludes_Sections(t *testing.T) {
+	fsys := fstest.MapFS{
+		"items": &fstest.MapFile{Data: []byte("open = Open\n[edit]\ncopy = Copy\n")},
+		"title": &fstest.MapFile{Data: []byte("open = MyApp\n")},
+	}
+	storages := storagesOf(t, "[menu]\n@include items\nclose = Close\n[]\n@include title\n", WithSections(), WithIncludes(fsys))
+	findAll(t, storages, map[string]string{
+		"menu.open":  "Open",
+		"edit.copy":  "Copy",
+		"menu.close": "Close",
+		"open":       "MyApp",
+	})
+}
+
+func TestWithIncludes_Cycle(t *testing.T) {
+	fsys := os.DirFS(path.Join(path_WORDS, "include"))
+	_, err := NewWordsCollectionFS(fsys, "cycle", core.Separator, core.Comment, WithIncludes(fsys))
+	var parseError *core.ParseError
+	if !errors.As(err, &parseError) || !errors.Is(err, core.ErrIncludeCycle) {
+		t.Fatalf("NewWordsCollectionFS() error = %v, want %v", err, core.ErrIncludeCycle)
+	}
+	if parseError.Source != "modules/cycle" || parseError.Line != 2 {
+		t.Errorf("NewWordsCollectionFS() error = %+v, want source %q line %v", parseError, "modules/cycle", 2)
+	}
+}
+
+func TestWithIncludes_Validate(t *testing.T) {
+	fsys := os.DirFS(path.Join(path_WORDS, "include"))
+	err := ValidateFS(fsys, "invalid", core.Separator, core.Comment, WithIncludes(fsys))
+	var parseErrors core.ParseErrors
+	if !errors.As(err, &parseErrors) {
+		t.Fatalf("ValidateFS() error = %v, want core.ParseErrors", err)
+	}
+	want := []struct {
+		source  string
+		line    int
+		wantErr error
+	}{
+		{"modules/invalid", 2, core.ErrSeparatorNotPresent},
+		{"invalid", 3, core.ErrIncludeInvalid},
+		{"invalid", 4, fs.ErrNotExist},
+		{"invalid", 5, core.ErrNameDuplicated},
+	}
+	if len(parseErrors) != len(want) {
+		t.Fatalf("ValidateFS() error = %v, want %v errors", err, len(want))
+	}
+	for index, parseError := range parseErrors {
+		if parseError.Source != want[index].source || parseError.Line != want[index].line || !errors.Is(parseError, want[index].wantErr) {
+			t.Errorf("ValidateFS() error = %+v, want source %q line %v error %v", parseError, want[index].source, want[index].line, want[index].wantErr)
+		}
+	}
+	const wantMessage string = "invalid: line 5, column 1: duplicated name found, name 'hello' is first defined at modules/invalid: line 1"
+	if message := parseErrors[3].Error(); message != wantMessage {
+		t.Errorf("ParseError.Error() = %q, want %q", message, wantMessage)
+	}
+}
+
+func TestWithIncludes_Disabled(t *testing.T) {
+	_, err := NewWordsCollection("k1 = v1\n@include modules/errors", core.Separator, core.Comment)
+	if !errors.Is(err, core.ErrSeparatorNotPresent) {
+		t.Errorf("NewWordsCollection() error = %v, want %v", err, core.ErrSeparatorNotPresent)
+	}
+}
+
+func TestWithSeparator(t *testing.T) {
+	storages := storagesWith(t, "delimiters", WithSeparator("=>"), WithComments("#", "//"))
+	findAll(t, storages, map[string]string{
+		"title":  "MyApp",
+		"url":    "http://example.com/?a=b",
+		"padded": "trailing",
+		"empty":  "",
+	})
+	if _, err := NewWordsCollection("title => MyApp\n// comment", core.Separator, core.Comment, WithSeparator("=>")); !errors.Is(err, core.ErrSeparatorNotPresent) {
+		t.Errorf("NewWordsCollection() error = %v, want %v", err, core.ErrSeparatorNotPresent)
+	}
+}
+
+func TestWithSeparator_Escapes(t *testing.T) {
+	storages := storagesOf(t, "a\\=>b => v1\n//c => v2\n\\//d => v3 // comment", WithSeparator("=>"), WithComments("//"), WithEscapes(), WithInlineComments())
+	findAll(t, storages, map[string]string{
+		"a=>b": "v1",
+		"//d":  "v3",
+	})
+	for kind, words := range storages {
+		var buffer bytes.Buffer
+		encoder, err := NewEncoder(&buffer, core.Separator, core.Comment, WithSeparator("=>"), WithComments("//"), WithEscapes(), WithInlineComments())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := encoder.Encode(words); err != nil {
+			t.Fatalf("%s Encoder.Encode() error = %v", kind, err)
+		}
+		const want string = "a\\=\\>b => v1\n\\//d => v3\n"
+		if got := buffer.String(); got != want {
+			t.Errorf("%s Encoder.Encode() = %q, want %q", kind, got, want)
+		}
+	}
+}
+
+func TestWithSeparator_Overlap(t *testing.T) {
+	const source string = "a: :: v\nb :: w\n>c=> :: x"
+	storages := storagesOf(t, source, WithSeparator("::"))
+	for _, kind := range []core.Storage{core.StorageRepository, core.StorageSorted} {
+		words, err := NewWords(source, WithSeparator("::"), WithStorage(kind))
+		if err != nil {
+			t.Fatal(err)
+		}
+		storages[fmt.Sprintf("NewWords %T", words)] = words
+	}
+	findAll(t, storages, map[string]string{
+		"a:":   "v",
+		"b":    "w",
+		">c=>": "x",
+	})
+	for kind, words := range storages {
+		if got, want := words.(Enumerable).Keys(), []string{"a:", "b", ">c=>"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.Keys() = %q, want %q", kind, got, want)
+		}
+	}
+}
+
+func TestWithSeparator_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		wantErr error
+	}{
+		{"space in separator", []Option{WithSeparator("= >")}, core.ErrDelimiterInvalid},
+		{"letter in separator", []Option{WithSeparator("is")}, core.ErrDelimiterInvalid},
+		{"backslash in separator", []Option{WithSeparator(`\=`)}, core.ErrDelimiterInvalid},
+		{"quote in comment", []Option{WithComments(`"`)}, core.ErrDelimiterInvalid},
+		{"empty comment", []Option{WithComments("#", "")}, core.ErrDelimiterInvalid},
+		{"no comments", []Option{WithComments()}, core.ErrDelimiterInvalid},
+		{"separator is comment", []Option{WithSeparator("::"), WithComments("#", "::")}, core.ErrSameSeparatorAndComment},
+		{"invalid comment character", []Option{WithSeparator("=>")}, core.ErrCommentIsInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollection("k = v", core.Separator, '!', tt.options...)
+			if tt.name != "invalid comment character" {
+				_, err = NewWordsCollection("k = v", core.Separator, core.Comment, tt.options...)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWordsCollection() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithTrim(t *testing.T) {
+	const source string = "k1 =  padded  \nk2 =\t\n  k3   = v3\t\n"
+	tests := []struct {
+		name   string
+		policy core.Trim
+		want   map[string]string
+	}{
+		{"both", core.TrimBoth, map[string]string{"k1": "padded", "k2": "", "k3": "v3"}},
+		{"leading", core.TrimLeading, map[string]string{"k1": "padded  ", "k2": "", "k3": "v3\t"}},
+		{"none", core.TrimNone, map[string]string{"k1": "  padded  ", "k2": "\t", "k3": " v3\t"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findAll(t, storagesOf(t, source, WithTrim(tt.policy)), tt.want)
+		})
+	}
+}
+
+func TestWithTrim_Encoder(t *testing.T) {
+	words, err := NewWordsCollection("k1 =  padded  \nk2 = <<<END\nline 1\nline 2\nEND", core.Separator, core.Comment, WithTrim(core.TrimNone), WithMultiline())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	encoder, err := NewEncoder(&buffer, core.Separator, core.Comment, WithTrim(core.TrimNone), WithMultiline())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := encoder.Encode(words); err != nil {
+		t.Fatalf("Encoder.Encode() error = %v", err)
+	}
+	const want string = "k1 =  padded  \nk2 =<<<END\nline 1\nline 2\nEND\n"
+	if got := buffer.String(); got != want {
+		t.Errorf("Encoder.Encode() = %q, want %q", got, want)
+	}
+}
+
+func TestWithDuplicates(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy core.Duplicates
+		want   map[string]string
+	}{
+		{"first", core.DuplicatesFirst, map[string]string{"title": "MyApp", "color": "red", "size": "10"}},
+		{"last", core.DuplicatesLast, map[string]string{"title": "Override", "color": "blue", "size": "10"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storages := storagesWith(t, "duplicates", WithDuplicates(tt.policy))
+			findAll(t, storages, tt.want)
+			for kind, words := range storages {
+				var buffer bytes.Buffer
+				if _, err := words.(io.WriterTo).WriteTo(&buffer); err != nil {
+					t.Fatalf("%s.WriteTo() error = %v", kind, err)
+				}
+				want := "title = " + tt.want["title"] + "\ncolor = " + tt.want["color"] + "\nsize = 10\n"
+				if got := buffer.String(); got != want {
+					t.Errorf("%s.WriteTo() = %q, want %q", kind, got, want)
+				}
+			}
+			if err := ValidateFS(os.DirFS(path_WORDS), "duplicates", core.Separator, core.Comment, WithDuplicates(tt.policy)); err != nil {
+				t.Errorf("ValidateFS() error = %v, want nil", err)
+			}
+		})
+	}
+	if _, err := NewWordsRepositoryFS(os.DirFS(path_WORDS), "duplicates", core.Separator, core.Comment); !errors.Is(err, core.ErrNameDuplicated) {
+		t.Errorf("NewWordsRepositoryFS() error = %v, want %v", err, core.ErrNameDuplicated)
+	}
+}
+
+func TestWithNormalizer(t *testing.T) {
+	storages := storagesOf(t, "Title = MyApp\n[Errors]\nNotFound = Not found", WithNormalizer(strings.ToLower), WithSections())
+	for kind, words := range storages {
+		for _, name := range []string{"title", "TITLE", " Title "} {
+			if value, found := words.Find(name); value != "MyApp" || !found {
+				t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, "MyApp", true)
+			}
+		}
+		if value, found := words.Find("ERRORS.notfound"); value != "Not found" || !found {
+			t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, "ERRORS.notfound", value, found, "Not found", true)
+		}
+	}
+
+	_, err := NewWordsCollection("Title = MyApp\ntitle = Other", core.Separator, core.Comment, WithNormalizer(strings.ToLower))
+	if !errors.Is(err, core.ErrNameDuplicated) {
+		t.Errorf("NewWordsCollection() error = %v, want %v", err, core.ErrNameDuplicated)
+	}
+	_, err = NewWordsCollection("k1 = v1\n--- = v2", core.Separator, core.Comment, WithNormalizer(func(name string) string { return strings.Trim(name, "-") }))
+	var parseError *core.ParseError
+	if !errors.As(err, &parseError) || !errors.Is(err, core.ErrNameNotPresent) || parseError.Line != 2 {
+		t.Errorf("NewWordsCollection() error = %v, want %v at line %v", err, core.ErrNameNotPresent, 2)
+	}
+}
+
+func TestWithOverrides(t *testing.T) {
+	fsys := os.DirFS(path.Join(path_WORDS, "layers"))
+	tests := []struct {
+		name   string
+		policy core.Duplicates
+		want   map[string]string
+		report []string
+	}{
+		{"last", core.DuplicatesLast, map[string]string{"title": "MyApp Pro", "color": "green", "size": "10"}, []string{
+			"overrides: line 2: name 'title' overrides main: line 1",
+			"overrides: line 3: name 'size' overrides main: line 3",
+			"main: line 5: name 'color' overrides line 2",
+		}},
+		{"first", core.DuplicatesFirst, map[string]string{"title": "MyApp", "color": "red", "size": "8"}, []string{
+			"overrides: line 2: name 'title' is ignored, kept at main: line 1",
+			"overrides: line 3: name 'size' is ignored, kept at main: line 3",
+			"main: line 5: name 'color' is ignored, kept at line 2",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var report []string
+			options := []Option{WithIncludes(fsys), WithDuplicates(tt.policy), WithOverrides(func(override core.Override) {
+				report = append(report, override.String())
+			})}
+			check := func(kind string, words Words) {
+				t.Helper()
+				for name, want := range tt.want {
+					if value, found := words.Find(name); value != want || !found {
+						t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, want, true)
+					}
+				}
+				if !reflect.DeepEqual(report, tt.report) {
+					t.Errorf("%s overrides = %q, want %q", kind, report, tt.report)
+				}
+				report = nil
+			}
+
+			wCollection, err := NewWordsCollectionFS(fsys, "main", core.Separator, core.Comment, options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check("WordsCollection", wCollection)
+			wRepository, err := NewWordsRepositoryFS(fsys, "main", core.Separator, core.Comment, options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check("WordsRepository", wRepository)
+			wFile, err := NewWordsFS(fsys, "main", core.Separator, core.Comment, options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer wFile.Close()
+			if len(report) > 0 {
+				t.Errorf("NewWordsFS() overrides = %q, want none before CheckError", report)
+			}
+			if err := wFile.CheckError(); err != nil {
+				t.Fatal(err)
+			}
+			check("WordsFile", wFile)
+			if err := ValidateFS(fsys, "main", core.Separator, core.Comment, options...); err != nil {
+				t.Fatal(err)
+			}
+			check("ValidateFS", wCollection)
+		})
+	}
+}
+
+func TestWithFolding(t *testing.T) {
+	storages := storagesOf(t, "App  Name = v1\nΤίτλος = v2\nSTRASSE = v3", WithFolding(core.FoldCase|core.FoldSpace))
+	for kind, words := range storages {
+		for name, want := range map[string]string{"app name": "v1", " APP \t NAME ": "v1", "τίτλος": "v2", "ΤΊΤΛΟΣ": "v2", "Strasse": "v3"} {
+			if value, found := words.Find(name); value != want || !found {
+				t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, want, true)
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		source  string
+		fold    core.Fold
+		wantErr error
+	}{
+		{"case", "App = v1\napp = v2", core.FoldCase, core.ErrNameDuplicated},
+		{"space", "App Name = v1\nApp   Name = v2", core.FoldSpace, core.ErrNameDuplicated},
+		{"case without rule", "App = v1\napp = v2", core.FoldSpace, nil},
+		{"space without rule", "App Name = v1\nApp   Name = v2", core.FoldCase, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWordsRepository(tt.source, core.Separator, core.Comment, WithFolding(tt.fold)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewWordsRepository() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := Validate(tt.source, core.Separator, core.Comment, WithFolding(tt.fold)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithFolding_Normalizer(t *testing.T) {
+	var report []core.Override
+	words, err := NewWords("App.Title = MyApp\ntitle = Override",
+		WithNormalizer(func(name string) string { return strings.TrimPrefix(strings.ToLower(name), "app.") }),
+		WithFolding(core.FoldCase),
+		WithDuplicates(core.DuplicatesLast),
+		WithOverrides(func(override core.Override) { report = append(report, override) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := words.Get("TITLE"); value != "Override" {
+		t.Errorf("Get() = %q, want %q", value, "Override")
+	}
+	if len(report) != 1 || report[0].Name != "title" || report[0].Line != 2 || report[0].Previous != 1 {
+		t.Errorf("overrides = %+v, want name %q line %v previous %v", report, "title", 2, 1)
+	}
+}
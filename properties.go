@@ -0,0 +1,24 @@
+package gowords
+
+import (
+	"io"
+
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromProperties create a new instance of WordsCollection by reading Java properties from reader,
+// such as resource bundles. It follows rules of "java.util.Properties": comment lines start with "#" or "!",
+// name is separated from value by "=", ":" or whitespace, lines ending with backslash are continued,
+// and escape sequences such as "\uXXXX" are decoded. The last value of a duplicated name is kept,
+// and the source is read as UTF-8 or UTF-16 with byte order mark.
+func NewWordsCollectionFromProperties(reader io.Reader) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParseProperties) })
+}
+
+// NewWordsRepositoryFromProperties create a new instance of WordsRepository by reading Java properties from reader,
+// same as "NewWordsCollectionFromProperties"
+func NewWordsRepositoryFromProperties(reader io.Reader) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readSource(reader, internal.ParseProperties) })
+}
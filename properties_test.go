@@ -0,0 +1,106 @@
+package gowords_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromProperties(t *testing.T) {
+	want := map[string]string{
+		"app.title":       "MyApp",
+		"app.version":     "1.0",
+		"app.name":        "MyApp Server",
+		"greeting":        "Hello, World",
+		"path":            `c:\temp\new`,
+		"tab":             "a\tb",
+		"unicode":         "سلام",
+		"emoji":           "😀",
+		"key with spaces": "spaced",
+		"key=equal":       "equal",
+		"key:colon":       "colon",
+		"empty":           "",
+		"novalue":         "",
+		"trailing":        "value   ",
+		"indented":        "yes",
+		"escaped#hash":    "hash",
+		"letter":          "q",
+	}
+	open := func() io.Reader {
+		file, err := os.Open(path.Join(path_WORDS, "messages.properties"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { file.Close() })
+		return file
+	}
+	wCollection, err := NewWordsCollectionFromProperties(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromProperties(open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}, want)
+}
+
+func TestNewWordsFromProperties_Duplicated(t *testing.T) {
+	const source string = "k1 = v1\nk2 = v2\nk1 = v3\n"
+	wCollection, err := NewWordsCollectionFromProperties(strings.NewReader(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromProperties(strings.NewReader(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	storages := map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}
+	findAll(t, storages, map[string]string{"k1": "v3", "k2": "v2"})
+	for kind, words := range storages {
+		if got, want := words.(Enumerable).Keys(), []string{"k1", "k2"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.Keys() = %q, want %q", kind, got, want)
+		}
+	}
+}
+
+func TestNewWordsFromProperties_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		wantErr    error
+		wantLine   int
+		wantColumn int
+	}{
+		{"invalid unicode", "k1 = v1\n  k2 = \\u12G4", core.ErrEscapeInvalid, 2, 3},
+		{"short unicode", "k1 = \\u12", core.ErrEscapeInvalid, 1, 1},
+		{"absent name", "k1 = v1\n= v2", core.ErrNameNotPresent, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromProperties(strings.NewReader(tt.source))
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewWordsCollectionFromProperties() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine || parseError.Column != tt.wantColumn {
+				t.Errorf("NewWordsCollectionFromProperties() error = %+v, want line %v column %v", parseError, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+
+	if _, err := NewWordsRepositoryFromProperties(nil); !errors.Is(err, core.ErrReaderNil) {
+		t.Errorf("NewWordsRepositoryFromProperties() error = %v, want %v", err, core.ErrReaderNil)
+	}
+}
@@ -1,7 +1,10 @@
 package gowords
 
 import (
-	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
 
 	"github.com/saleh-rahimzadeh/go-words/core"
 	"github.com/saleh-rahimzadeh/go-words/internal"
@@ -12,7 +15,10 @@ import (
 // WordsRepository provide words table and text resource with accepting string source and storing in array
 type WordsRepository struct {
 	repository []string
-	separator  rune
+	sorted     []int // Indexes of repository in sorted order of names, the index of searching by prefix
+	separator  string
+	escaped    bool
+	normalize  func(string) string // Function to normalize searched names, nil keeps names as is
 }
 
 //──────────────────────────────────────────────────────────────────────────────────────────────────
@@ -29,46 +35,189 @@ func (w WordsRepository) Find(name string) (string, bool) {
 	if !ok {
 		return internal.Empty, false
 	}
-	var separator = string(w.separator)
+	if w.normalize != nil {
+		name = w.normalize(name)
+	}
+	var extract func(string, string, string) (string, bool) = internal.Extract
+	if w.escaped {
+		name = internal.EscapeText(name, w.separator)
+		extract = internal.ExtractEscaped
+	}
 	for _, line := range w.repository {
-		if value, found := internal.Extract(line, name, separator); found {
+		if value, found := extract(line, name, w.separator); found {
 			return value, true
 		}
 	}
 	return internal.Empty, false
 }
 
+// WriteTo write canonical source of words to writer in order of source, by default separator and comment with escapes,
+// which is parsed to same words by "WithEscapes" option. Implement "io.WriterTo".
+func (w WordsRepository) WriteTo(writer io.Writer) (int64, error) {
+	return writeTo(writer, w)
+}
+
+// Len return number of names
+func (w WordsRepository) Len() int {
+	return len(w.repository)
+}
+
+// Keys return all names in order of source
+func (w WordsRepository) Keys() []string {
+	keys, _ := keysOf(w)
+	return keys
+}
+
+// Range call yield for each name and value in order of source, stop when yield return `false`
+func (w WordsRepository) Range(yield func(name string, value string) bool) {
+	_ = w.walk(yield)
+}
+
+// FindPrefix return all names which start with prefix and their values, the prefix is normalized like names
+func (w WordsRepository) FindPrefix(prefix string) map[string]string {
+	return collectPrefix(func(yield func(string, string) bool) { w.ScanPrefix(prefix, yield) })
+}
+
+// ScanPrefix call yield for each name which start with prefix and its value in sorted order of names,
+// stop when yield return `false`. The prefix is normalized like names, and names are found by binary search.
+func (w WordsRepository) ScanPrefix(prefix string, yield func(name string, value string) bool) {
+	if w.normalize != nil {
+		prefix = w.normalize(prefix)
+	}
+	var start = sort.Search(len(w.sorted), func(index int) bool {
+		name, _ := w.pair(w.repository[w.sorted[index]])
+		return name >= prefix
+	})
+	for _, line := range w.sorted[start:] {
+		name, value := w.pair(w.repository[line])
+		if !strings.HasPrefix(name, prefix) || !yield(name, value) {
+			return
+		}
+	}
+}
+
+// normalization return the function to normalize names, nil if names are kept as is
+func (w WordsRepository) normalization() func(string) string {
+	return w.normalize
+}
+
+// walk call yield for each name and value in order of source, stop when yield return `false`
+func (w WordsRepository) walk(yield func(name string, value string) bool) error {
+	for _, line := range w.repository {
+		if !yield(w.pair(line)) {
+			break
+		}
+	}
+	return nil
+}
+
+// pair split line of repository to name and value
+func (w WordsRepository) pair(line string) (string, string) {
+	return splitLine(line, w.separator, w.escaped)
+}
+
+// joinLine create a line of name and value, the name is escaped if escaped is `true`
+// to keep the first separator of line as delimiter of name and value
+func joinLine(name string, value string, separator string, escaped bool) string {
+	if escaped {
+		name = internal.EscapeText(name, separator)
+	}
+	return name + separator + value
+}
+
+// splitLine split line created by "joinLine" to name and value
+func splitLine(line string, separator string, escaped bool) (string, string) {
+	var index int
+	if escaped {
+		index = internal.IndexUnescaped(line, separator)
+	} else {
+		index = strings.Index(line, separator)
+	}
+	var name = line[:index]
+	if escaped {
+		name, _, _ = internal.Unescape(name)
+	}
+	return name, line[index+len(separator):]
+}
+
 //──────────────────────────────────────────────────────────────────────────────────────────────────
 
 // NewWordsRepository create a new instance of WordsRepository
-func NewWordsRepository(source string, separator rune, comment rune) (WordsRepository, error) {
-	var (
-		separatorCharacter string = string(separator)
-		commentCharacter   string = string(comment)
-		err                error
-	)
+func NewWordsRepository(source string, separator rune, comment rune, options ...Option) (WordsRepository, error) {
+	return newWordsRepository(source, internal.Empty, separator, comment, options)
+}
 
-	err = internal.ValidationSource(source)
+// newWordsRepository create a new instance of WordsRepository, the name of source is used in errors
+func newWordsRepository(source string, name string, separator rune, comment rune, options []Option) (WordsRepository, error) {
+	source = internal.DecodeUnicodeText(source)
+
+	err := internal.ValidationSource(source)
 	if err != nil {
 		return WordsRepository{}, err
 	}
 
-	err = internal.ValidationDelimiters(separatorCharacter, commentCharacter)
+	config, err := configure(separator, comment, options)
 	if err != nil {
 		return WordsRepository{}, err
 	}
 
-	repository, err := internal.Normalization(source, separatorCharacter, commentCharacter)
+	records, err := internal.Collect(internal.NewScanner(strings.NewReader(source), name, config.grammar))
 	if err != nil {
 		return WordsRepository{}, err
 	}
 
-	if duplicated, name := internal.CheckDuplication(repository, separatorCharacter); duplicated {
-		return WordsRepository{}, fmt.Errorf("%w, name '%s'", core.ErrNameDuplicated, name)
+	var grammar = config.grammar
+	var repository = repositoryOf(records, grammar.Separator, grammar.Escaped())
+	repository.normalize = grammar.Normalize
+	return repository, nil
+}
+
+// repositoryOf create a new instance of WordsRepository from unique records,
+// names are escaped if they may contain separator
+func repositoryOf(records []internal.Record, separator string, escaped bool) WordsRepository {
+	var (
+		repository []string = make([]string, len(records))
+		sorted     []int    = make([]int, len(records))
+	)
+	for index, record := range records {
+		sorted[index] = index
+		repository[index] = joinLine(record.Key, record.Value, separator, escaped)
 	}
 
+	sort.Slice(sorted, func(i int, j int) bool { return records[sorted[i]].Key < records[sorted[j]].Key })
+
 	return WordsRepository{
 		repository: repository,
+		sorted:     sorted,
 		separator:  separator,
-	}, nil
+		escaped:    escaped,
+	}
+}
+
+// NewWordsRepositoryFromReader create a new instance of WordsRepository by reading whole source from reader
+func NewWordsRepositoryFromReader(reader io.Reader, separator rune, comment rune, options ...Option) (WordsRepository, error) {
+	if reader == nil {
+		return WordsRepository{}, core.ErrReaderNil
+	}
+
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return WordsRepository{}, err
+	}
+
+	return newWordsRepository(string(source), internal.SourceName(reader), separator, comment, options)
+}
+
+// NewWordsRepositoryFS create a new instance of WordsRepository by reading whole source from named file of file system
+func NewWordsRepositoryFS(fsys fs.FS, name string, separator rune, comment rune, options ...Option) (WordsRepository, error) {
+	if fsys == nil {
+		return WordsRepository{}, core.ErrFileSystemNil
+	}
+
+	source, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return WordsRepository{}, err
+	}
+
+	return newWordsRepository(string(source), name, separator, comment, options)
 }
@@ -1,9 +1,16 @@
 package gowords_test
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
 
 	. "github.com/saleh-rahimzadeh/go-words"
 
@@ -123,6 +130,122 @@ func TestWordsRepository_Find(t *testing.T) {
 	}
 }
 
+func TestNewWordsRepositoryFromReader(t *testing.T) {
+	// Arrange
+	file, err := os.Open(path.Join(path_WORDS, "valid__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	// Act
+	w, err := NewWordsRepositoryFromReader(file, core.Separator, core.Comment)
+	// Assert
+	if err != nil {
+		t.Errorf("NewWordsRepositoryFromReader() error = %v", err)
+		return
+	}
+	if got := w.Get("k1"); got != "v1" {
+		t.Errorf("WordsRepository.Get() = %v, want %v", got, "v1")
+	}
+}
+
+func TestNewWordsRepositoryFromReader_Instantiation(t *testing.T) {
+	invalid_absent_name, _ := os.ReadFile(path.Join(path_WORDS, "invalid_absent_name"))
+	type args struct {
+		reader    io.Reader
+		separator rune
+		comment   rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check nil reader", args{reader: nil, separator: core.Separator, comment: core.Comment}, core.ErrReaderNil},
+		{"check invalid source", args{reader: strings.NewReader(internal.Empty), separator: core.Separator, comment: core.Comment}, core.ErrWordsEmpty},
+		{"check invalid separator delimiters", args{reader: strings.NewReader("k=v"), separator: 'x', comment: core.Comment}, core.ErrSeparatorIsInvalid},
+		{"check invalid normalization", args{reader: bytes.NewReader(invalid_absent_name), separator: core.Separator, comment: core.Comment}, core.ErrNameNotPresent},
+		{"check failed reader", args{reader: iotest.ErrReader(io.ErrUnexpectedEOF), separator: core.Separator, comment: core.Comment}, io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWordsRepositoryFromReader(tt.args.reader, tt.args.separator, tt.args.comment); !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsRepositoryFromReader() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWordsRepositoryFS(t *testing.T) {
+	// Arrange
+	fsys := os.DirFS(path_WORDS)
+	// Act
+	w, err := NewWordsRepositoryFS(fsys, "valid__source", core.Separator, core.Comment)
+	// Assert
+	if err != nil {
+		t.Errorf("NewWordsRepositoryFS() error = %v", err)
+		return
+	}
+	if got := w.Get("k1"); got != "v1" {
+		t.Errorf("WordsRepository.Get() = %v, want %v", got, "v1")
+	}
+}
+
+func TestNewWordsRepositoryFS_Instantiation(t *testing.T) {
+	fsys := fstest.MapFS{
+		"empty":     &fstest.MapFile{},
+		"duplicate": &fstest.MapFile{Data: []byte("k1=v1\nk1=v2")},
+	}
+	type args struct {
+		fsys fs.FS
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check nil file system", args{fsys: nil, name: "empty"}, core.ErrFileSystemNil},
+		{"check not exist file", args{fsys: fsys, name: "notexist"}, fs.ErrNotExist},
+		{"check empty file", args{fsys: fsys, name: "empty"}, core.ErrWordsEmpty},
+		{"check duplicated", args{fsys: fsys, name: "duplicate"}, core.ErrNameDuplicated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWordsRepositoryFS(tt.args.fsys, tt.args.name, core.Separator, core.Comment); !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsRepositoryFS() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWordsRepository_ParseError(t *testing.T) {
+	fsys := os.DirFS(path_WORDS)
+	tests := []struct {
+		name         string
+		file         string
+		want         error
+		wantLine     int
+		wantPrevious int
+	}{
+		{"absent name", "invalid_absent_name", core.ErrNameNotPresent, 2, 0},
+		{"no separator", "invalid_no_separator", core.ErrSeparatorNotPresent, 2, 0},
+		{"duplicated", "collection_duplicate", core.ErrNameDuplicated, 4, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsRepositoryFS(fsys, tt.file, core.Separator, core.Comment)
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.want) {
+				t.Fatalf("NewWordsRepositoryFS() error = %v, want %v", err, tt.want)
+			}
+			if parseError.Source != tt.file || parseError.Line != tt.wantLine || parseError.Previous != tt.wantPrevious {
+				t.Errorf("NewWordsRepositoryFS() error = %+v, want source %v line %v previous %v", parseError, tt.file, tt.wantLine, tt.wantPrevious)
+			}
+		})
+	}
+}
+
 //┌ Benchmark
 //└─────────────────────────────────────────────────────────────────────────────────────────────────
 
@@ -0,0 +1,54 @@
+package gowords
+
+import (
+	"io"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Resource a localized resource file of one language, to load resources of several languages into one storage.
+// Names of resource are joined by its suffix, such as "title_FA" for suffix "_FA", to search by "WithSuffix".
+type Resource struct {
+	Reader io.Reader   // Reader of resource file
+	Suffix core.Suffix // Suffix of names of resource, empty for no suffix
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// readResources read and parse records of all resources, names are joined by suffixes of resources and must be unique
+func readResources(resources []Resource, parse func([]byte, string) ([]internal.Record, error)) ([]internal.Record, error) {
+	if len(resources) == 0 {
+		return nil, core.ErrResourcesEmpty
+	}
+
+	var (
+		names   map[string]internal.Position = make(map[string]internal.Position)
+		records []internal.Record
+	)
+	for _, resource := range resources {
+		var suffix string
+		if resource.Suffix != "" {
+			var ok bool
+			if suffix, ok = internal.ValidationSuffix(string(resource.Suffix)); !ok {
+				return nil, core.ErrSuffixIsInvalid
+			}
+		}
+
+		parsed, err := readSource(resource.Reader, parse)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range parsed {
+			record.Key += suffix
+			if previous, found := names[record.Key]; found {
+				return nil, internal.Duplication(record, previous)
+			}
+			names[record.Key] = record.Position
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
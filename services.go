@@ -28,3 +28,64 @@ func FindBy(w Words, name string, suffix core.Suffix) (string, bool) {
 	}
 	return w.Find(name + strsuffix)
 }
+
+// GetByPrefix a helper to search for a name by prefix and using Words object,
+// then return value if found, else return empty string.
+// Also return empty string if prefix is invalid.
+func GetByPrefix(w Words, prefix core.Prefix, name string) string {
+	value, _ := FindByPrefix(w, prefix, name)
+	return value
+}
+
+// FindByPrefix a helper to search for a name by prefix and using Words object,
+// then return value and `true` if found, else return empty string and `false`.
+// Also return empty string and `false` if prefix is invalid.
+func FindByPrefix(w Words, prefix core.Prefix, name string) (string, bool) {
+	strprefix, ok := internal.ValidationPrefix(string(prefix))
+	if !ok {
+		return internal.Empty, false
+	}
+	name, ok = internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, false
+	}
+	return w.Find(strprefix + name)
+}
+
+// FindErr a helper to search for a name using Words object,
+// then return value and `true` if found, else return empty string and `false`.
+// Also return the error occurred in this call if Words object implements FallibleWords, else return nil error.
+func FindErr(w Words, name string) (string, bool, error) {
+	if fallible, ok := w.(FallibleWords); ok {
+		return fallible.FindErr(name)
+	}
+	value, found := w.Find(name)
+	return value, found, nil
+}
+
+// FindPrefix a helper to search for all names which start with prefix using Words object,
+// then return names and their values. Words must be PrefixWords or Enumerable, else "core.ErrWordsNotEnumerable" is returned.
+func FindPrefix(w Words, prefix string) (map[string]string, error) {
+	var pairs = make(map[string]string)
+	err := ScanPrefix(w, prefix, func(name string, value string) bool {
+		pairs[name] = value
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// ScanPrefix a helper to call yield for each name which start with prefix and its value in sorted order of names
+// using Words object, stop when yield return `false`.
+// The index of PrefixWords is used if Words object implements it, else all names of Enumerable are read,
+// otherwise "core.ErrWordsNotEnumerable" is returned.
+func ScanPrefix(w Words, prefix string, yield func(name string, value string) bool) error {
+	if indexed, ok := w.(PrefixWords); ok {
+		indexed.ScanPrefix(prefix, yield)
+		return nil
+	}
+
+	return scanSorted(func(yield func(string, string) bool) error { return enumerate(w, yield) }, prefix, yield)
+}
@@ -1,8 +1,10 @@
 package gowords_test
 
 import (
+	"errors"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	. "github.com/saleh-rahimzadeh/go-words"
@@ -106,3 +108,124 @@ func TestFindBy(t *testing.T) {
 		})
 	}
 }
+
+func TestFindErr(t *testing.T) {
+	wRepository, err := NewWordsRepository("k1=v1", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path.Join(path_WORDS, "invalid_absent_name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	wFile, err := NewWordsFile(file, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		words   Words
+		arg     string
+		want    string
+		found   bool
+		wantErr error
+	}{
+		{"found", wRepository, "k1", "v1", true, nil},
+		{"notfound", wRepository, key_NOTFOUND, internal.Empty, false, nil},
+		{"fallible", wFile, "k3", internal.Empty, false, core.ErrNameNotPresent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, gotErr := FindErr(tt.words, tt.arg)
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("FindErr() error = %v, want %v", gotErr, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FindErr() got = %v, want %v", got, tt.want)
+			}
+			if found != tt.found {
+				t.Errorf("FindErr() found = %v, want %v", found, tt.found)
+			}
+		})
+	}
+}
+
+func TestFindPrefix(t *testing.T) {
+	w, err := NewWordsCollection("b_EN = B\na_EN = A\na_FA = آ\nc = C", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wEN, err := NewWithSuffix(w, "_EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		words   Words
+		prefix  string
+		want    map[string]string
+		wantErr error
+	}{
+		{"prefix words", w, "a_", map[string]string{"a_EN": "A", "a_FA": "آ"}, nil},
+		{"enumerable", wEN, "", map[string]string{"a": "A", "b": "B"}, nil},
+		{"not enumerable", wordsMap{"a": "A"}, "a", nil, core.ErrWordsNotEnumerable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindPrefix(tt.words, tt.prefix)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindPrefix() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var names []string
+	err = ScanPrefix(wEN, "", func(name string, _ string) bool {
+		names = append(names, name)
+		return true
+	})
+	if want := []string{"a", "b"}; err != nil || !reflect.DeepEqual(names, want) {
+		t.Errorf("ScanPrefix() = %q, %v, want %q, nil", names, err, want)
+	}
+}
+
+func TestGetByPrefix(t *testing.T) {
+	wRepository, err := NewWordsRepositoryFS(os.DirFS(path_WORDS), "withprefix", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const BILLING core.Prefix = "billing."
+	type args struct {
+		w      Words
+		prefix core.Prefix
+		name   string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      string
+		wantFound bool
+	}{
+		{"found", args{wRepository, BILLING, "title"}, "Billing", true},
+		{"found space", args{wRepository, " " + BILLING, " title "}, "Billing", true},
+		{"found other prefix", args{wRepository, "shipping.", "title"}, "Shipping", true},
+		{"notfound", args{wRepository, BILLING, key_NOTFOUND}, internal.Empty, false},
+		{"empty name", args{wRepository, BILLING, internal.Empty}, internal.Empty, false},
+		{"empty prefix", args{wRepository, core.Prefix(internal.Empty), "title"}, internal.Empty, false},
+		{"invalid prefix", args{wRepository, "  ", "title"}, internal.Empty, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetByPrefix(tt.args.w, tt.args.prefix, tt.args.name); got != tt.want {
+				t.Errorf("GetByPrefix() = %v, want %v", got, tt.want)
+			}
+			if got, found := FindByPrefix(tt.args.w, tt.args.prefix, tt.args.name); got != tt.want || found != tt.wantFound {
+				t.Errorf("FindByPrefix() = %v, %v, want %v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package gowords
+
+import (
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WordsSorted provide words table and text resource with accepting string source and storing in array sorted by names,
+// so names are found by binary search
+type WordsSorted struct {
+	sorted    []string // Lines in sorted order of names
+	order     []int    // Indexes of sorted in order of source
+	separator string
+	escaped   bool
+	normalize func(string) string // Function to normalize searched names, nil keeps names as is
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Get search for a name then return value if found, else return empty string
+func (w WordsSorted) Get(name string) string {
+	value, _ := w.Find(name)
+	return value
+}
+
+// Find search for a name by binary search then return value and `true` if found, else return empty string and `false`
+func (w WordsSorted) Find(name string) (string, bool) {
+	name, ok := internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, false
+	}
+	if w.normalize != nil {
+		name = w.normalize(name)
+	}
+	var index = w.search(name)
+	if index == len(w.sorted) {
+		return internal.Empty, false
+	}
+	if key, value := w.pair(w.sorted[index]); key == name {
+		return value, true
+	}
+	return internal.Empty, false
+}
+
+// WriteTo write canonical source of words to writer in order of source, by default separator and comment with escapes,
+// which is parsed to same words by "WithEscapes" option. Implement "io.WriterTo".
+func (w WordsSorted) WriteTo(writer io.Writer) (int64, error) {
+	return writeTo(writer, w)
+}
+
+// Len return number of names
+func (w WordsSorted) Len() int {
+	return len(w.sorted)
+}
+
+// Keys return all names in order of source
+func (w WordsSorted) Keys() []string {
+	keys, _ := keysOf(w)
+	return keys
+}
+
+// Range call yield for each name and value in order of source, stop when yield return `false`.
+// Names in sorted order are scanned by "ScanPrefix" with an empty prefix.
+func (w WordsSorted) Range(yield func(name string, value string) bool) {
+	_ = w.walk(yield)
+}
+
+// FindPrefix return all names which start with prefix and their values, the prefix is normalized like names
+func (w WordsSorted) FindPrefix(prefix string) map[string]string {
+	return collectPrefix(func(yield func(string, string) bool) { w.ScanPrefix(prefix, yield) })
+}
+
+// ScanPrefix call yield for each name which start with prefix and its value in sorted order of names,
+// stop when yield return `false`. The prefix is normalized like names, and names are found by binary search.
+func (w WordsSorted) ScanPrefix(prefix string, yield func(name string, value string) bool) {
+	if w.normalize != nil {
+		prefix = w.normalize(prefix)
+	}
+	for _, line := range w.sorted[w.search(prefix):] {
+		name, value := w.pair(line)
+		if !strings.HasPrefix(name, prefix) || !yield(name, value) {
+			return
+		}
+	}
+}
+
+// normalization return the function to normalize names, nil if names are kept as is
+func (w WordsSorted) normalization() func(string) string {
+	return w.normalize
+}
+
+// walk call yield for each name and value in order of source, stop when yield return `false`
+func (w WordsSorted) walk(yield func(name string, value string) bool) error {
+	for _, index := range w.order {
+		if !yield(w.pair(w.sorted[index])) {
+			break
+		}
+	}
+	return nil
+}
+
+// search return index of the first line which its name is not less than name, by binary search
+func (w WordsSorted) search(name string) int {
+	return sort.Search(len(w.sorted), func(index int) bool {
+		key, _ := w.pair(w.sorted[index])
+		return key >= name
+	})
+}
+
+// pair split line to name and value
+func (w WordsSorted) pair(line string) (string, string) {
+	return splitLine(line, w.separator, w.escaped)
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsSorted create a new instance of WordsSorted
+func NewWordsSorted(source string, separator rune, comment rune, options ...Option) (WordsSorted, error) {
+	return newWordsSorted(source, internal.Empty, separator, comment, options)
+}
+
+// newWordsSorted create a new instance of WordsSorted, the name of source is used in errors
+func newWordsSorted(source string, name string, separator rune, comment rune, options []Option) (WordsSorted, error) {
+	source = internal.DecodeUnicodeText(source)
+
+	err := internal.ValidationSource(source)
+	if err != nil {
+		return WordsSorted{}, err
+	}
+
+	config, err := configure(separator, comment, options)
+	if err != nil {
+		return WordsSorted{}, err
+	}
+
+	records, err := internal.Collect(internal.NewScanner(strings.NewReader(source), name, config.grammar))
+	if err != nil {
+		return WordsSorted{}, err
+	}
+
+	var grammar = config.grammar
+	var sorted = sortedOf(records, grammar.Separator, grammar.Escaped())
+	sorted.normalize = grammar.Normalize
+	return sorted, nil
+}
+
+// sortedOf create a new instance of WordsSorted from unique records,
+// names are escaped if they may contain separator
+func sortedOf(records []internal.Record, separator string, escaped bool) WordsSorted {
+	var indexes = make([]int, len(records))
+	for index := range indexes {
+		indexes[index] = index
+	}
+	sort.Slice(indexes, func(i int, j int) bool { return records[indexes[i]].Key < records[indexes[j]].Key })
+
+	var (
+		sorted []string = make([]string, len(records))
+		order  []int    = make([]int, len(records))
+	)
+	for index, record := range indexes {
+		sorted[index] = joinLine(records[record].Key, records[record].Value, separator, escaped)
+		order[record] = index
+	}
+
+	return WordsSorted{
+		sorted:    sorted,
+		order:     order,
+		separator: separator,
+		escaped:   escaped,
+	}
+}
+
+// NewWordsSortedFromReader create a new instance of WordsSorted by reading whole source from reader
+func NewWordsSortedFromReader(reader io.Reader, separator rune, comment rune, options ...Option) (WordsSorted, error) {
+	if reader == nil {
+		return WordsSorted{}, core.ErrReaderNil
+	}
+
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return WordsSorted{}, err
+	}
+
+	return newWordsSorted(string(source), internal.SourceName(reader), separator, comment, options)
+}
+
+// NewWordsSortedFS create a new instance of WordsSorted by reading whole source from named file of file system
+func NewWordsSortedFS(fsys fs.FS, name string, separator rune, comment rune, options ...Option) (WordsSorted, error) {
+	if fsys == nil {
+		return WordsSorted{}, core.ErrFileSystemNil
+	}
+
+	source, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return WordsSorted{}, err
+	}
+
+	return newWordsSorted(string(source), name, separator, comment, options)
+}
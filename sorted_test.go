@@ -0,0 +1,227 @@
+package gowords_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsSorted(t *testing.T) {
+	// Arrange
+	valid_source, err := os.ReadFile(path.Join(path_WORDS, "valid__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Act
+	_, err = NewWordsSorted(string(valid_source), core.Separator, core.Comment)
+	// Assert
+	if err != nil {
+		t.Errorf("NewWordsSorted() error = %v", err)
+		return
+	}
+}
+
+func TestNewWordsSorted_Instantiation(t *testing.T) {
+	valid_source, err := os.ReadFile(path.Join(path_WORDS, "valid__want"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid_absent_name, _ := os.ReadFile(path.Join(path_WORDS, "invalid_absent_name"))
+	data_duplicated, _ := os.ReadFile(path.Join(path_WORDS, "collection_duplicate"))
+	type args struct {
+		source    string
+		separator rune
+		comment   rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check invalid source", args{source: internal.Empty, separator: core.Separator, comment: core.Comment}, core.ErrWordsEmpty},
+		{"check invalid separator delimiters", args{source: string(valid_source), separator: 'x', comment: core.Comment}, core.ErrSeparatorIsInvalid},
+		{"check invalid comment delimiters", args{source: string(valid_source), separator: core.Separator, comment: 'x'}, core.ErrCommentIsInvalid},
+		{"check invalid normalization", args{source: string(invalid_absent_name), separator: core.Separator, comment: core.Comment}, core.ErrNameNotPresent},
+		{"check invalid duplication", args{source: string(data_duplicated), separator: core.Separator, comment: core.Comment}, core.ErrNameDuplicated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWordsSorted(tt.args.source, tt.args.separator, tt.args.comment); !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsSorted() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsSorted_Find(t *testing.T) {
+	source, err := os.ReadFile(path.Join(path_WORDS, "valid_sparse__want"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWordsSorted(string(source), core.Separator, core.Comment)
+	if err != nil {
+		t.Errorf("NewWordsSorted() error = %v", err)
+		return
+	}
+	tests := []struct {
+		name  string
+		arg   string
+		want  string
+		found bool
+	}{
+		{"found", "k1", "v1", true},
+		{"found empty", "k11", internal.Empty, true},
+		{"notfound", key_NOTFOUND, internal.Empty, false},
+		{"notfound prefix", "k", internal.Empty, false},
+		{"notfound last", "zzz", internal.Empty, false},
+		{"empty", internal.Empty, internal.Empty, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := w.Find(tt.arg)
+			if got != tt.want {
+				t.Errorf("WordsSorted.Find() got = %v, want %v", got, tt.want)
+			}
+			if found != tt.found {
+				t.Errorf("WordsSorted.Find() found = %v, want %v", found, tt.found)
+			}
+			if got := w.Get(tt.arg); got != tt.want {
+				t.Errorf("WordsSorted.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsSorted_Order(t *testing.T) {
+	const source string = "c = 3\na.b = 2\nb = 4\na = 1\n"
+	w, err := NewWordsSorted(source, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.Keys(), []string{"c", "a.b", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WordsSorted.Keys() = %q, want %q", got, want)
+	}
+	var got []string
+	w.ScanPrefix(internal.Empty, func(name string, value string) bool {
+		got = append(got, name+"="+value)
+		return true
+	})
+	if want := []string{"a=1", "a.b=2", "b=4", "c=3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WordsSorted.ScanPrefix() = %q, want %q", got, want)
+	}
+}
+
+func TestWordsSorted_Escaped(t *testing.T) {
+	const source string = "[menu]\nk\\=1 = v1\nk = v2\n\n[]\nk\\=0 = v0\n"
+	w, err := NewWordsSorted(source, core.Separator, core.Comment, WithEscapes(), WithSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"menu.k=1": "v1", "menu.k": "v2", "k=0": "v0"}
+	for name, wantValue := range want {
+		if value, found := w.Find(name); value != wantValue || !found {
+			t.Errorf("WordsSorted.Find(%q) = %q, %v, want %q, %v", name, value, found, wantValue, true)
+		}
+	}
+	if got := w.FindPrefix("menu."); !reflect.DeepEqual(got, map[string]string{"menu.k=1": "v1", "menu.k": "v2"}) {
+		t.Errorf("WordsSorted.FindPrefix() = %v", got)
+	}
+}
+
+func TestNewWordsSortedFromReader(t *testing.T) {
+	invalid_absent_name, _ := os.ReadFile(path.Join(path_WORDS, "invalid_absent_name"))
+	type args struct {
+		reader io.Reader
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check valid", args{reader: strings.NewReader("k1 = v1")}, nil},
+		{"check nil reader", args{reader: nil}, core.ErrReaderNil},
+		{"check invalid source", args{reader: strings.NewReader(internal.Empty)}, core.ErrWordsEmpty},
+		{"check invalid normalization", args{reader: strings.NewReader(string(invalid_absent_name))}, core.ErrNameNotPresent},
+		{"check failed reader", args{reader: iotest.ErrReader(io.ErrUnexpectedEOF)}, io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, got := NewWordsSortedFromReader(tt.args.reader, core.Separator, core.Comment)
+			if !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsSortedFromReader() error = %v, want = %v", got, tt.want)
+			}
+			if got == nil && w.Get("k1") != "v1" {
+				t.Errorf("WordsSorted.Get() = %v, want %v", w.Get("k1"), "v1")
+			}
+		})
+	}
+}
+
+func TestNewWordsSortedFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"valid":     &fstest.MapFile{Data: []byte("k1=v1\nk2=v2")},
+		"empty":     &fstest.MapFile{},
+		"duplicate": &fstest.MapFile{Data: []byte("k1=v1\nk1=v2")},
+	}
+	type args struct {
+		fsys fs.FS
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check valid", args{fsys: fsys, name: "valid"}, nil},
+		{"check nil file system", args{fsys: nil, name: "empty"}, core.ErrFileSystemNil},
+		{"check not exist file", args{fsys: fsys, name: "notexist"}, fs.ErrNotExist},
+		{"check empty file", args{fsys: fsys, name: "empty"}, core.ErrWordsEmpty},
+		{"check duplicated", args{fsys: fsys, name: "duplicate"}, core.ErrNameDuplicated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, got := NewWordsSortedFS(tt.args.fsys, tt.args.name, core.Separator, core.Comment)
+			if !errors.Is(got, tt.want) {
+				t.Errorf("NewWordsSortedFS() error = %v, want = %v", got, tt.want)
+			}
+			if got == nil && w.Get("k1") != "v1" {
+				t.Errorf("WordsSorted.Get() = %v, want %v", w.Get("k1"), "v1")
+			}
+		})
+	}
+}
+
+//┌ Benchmark
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func BenchmarkWordsSorted(b *testing.B) {
+	source, err := os.ReadFile(path.Join(path_BENCHMARK, "normalization__large"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	w, err := NewWordsSorted(string(source), core.Separator, core.Comment)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		_, found := w.Find("k1000")
+		if !found {
+			b.Fatal(benchmark_KEY_NOTFOUND)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package gowords
+
+import (
+	"io"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromCSV create a new instance of WordsCollection by reading a table of all languages from reader,
+// such as a spreadsheet with columns "key,EN,FA,DE". Fields are separated by comma, use ',' for CSV and '\t' for TSV.
+// The header row is the name column and language columns, each row is expanded to names joined by "_" and language,
+// such as "title_EN" and "title_FA", to search by "WithSuffix" with suffixes like "_EN". Empty cells are skipped.
+func NewWordsCollectionFromCSV(reader io.Reader, comma rune) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readTable(reader, comma) })
+}
+
+// NewWordsRepositoryFromCSV create a new instance of WordsRepository by reading a table of all languages from reader,
+// same as "NewWordsCollectionFromCSV"
+func NewWordsRepositoryFromCSV(reader io.Reader, comma rune) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readTable(reader, comma) })
+}
+
+// NewWordsCollectionsFromCSV create an instance of WordsCollection for each language column of a table from reader,
+// mapped by name of language in header row. The table is same as "NewWordsCollectionFromCSV", but names have no suffix.
+func NewWordsCollectionsFromCSV(reader io.Reader, comma rune) (map[string]WordsCollection, error) {
+	languages, cells, err := readCells(reader, comma)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns = make([][]internal.Record, len(languages))
+	for _, cell := range cells {
+		columns[cell.Language] = append(columns[cell.Language], cell.Record)
+	}
+	var collections = make(map[string]WordsCollection, len(languages))
+	for index, language := range languages {
+		collections[language] = collectionOf(columns[index])
+	}
+	return collections, nil
+}
+
+// readTable read cells of a table from reader as records of names joined by suffix of language,
+// return error if joined names are duplicated, such as name "a_EN" of column "FA" and name "a" of column "EN_FA"
+func readTable(reader io.Reader, comma rune) ([]internal.Record, error) {
+	languages, cells, err := readCells(reader, comma)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		names   map[string]internal.Position = make(map[string]internal.Position, len(cells))
+		records []internal.Record            = make([]internal.Record, 0, len(cells))
+	)
+	for _, cell := range cells {
+		cell.Key += internal.SuffixDelimiter + languages[cell.Language]
+		if previous, found := names[cell.Key]; found {
+			return nil, internal.Duplication(cell.Record, previous)
+		}
+		names[cell.Key] = cell.Position
+		records = append(records, cell.Record)
+	}
+	return records, nil
+}
+
+// readCells read whole table from reader and parse its languages and cells
+func readCells(reader io.Reader, comma rune) ([]string, []internal.Cell, error) {
+	if reader == nil {
+		return nil, nil, core.ErrReaderNil
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return internal.ParseTable(data, internal.SourceName(reader), comma)
+}
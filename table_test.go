@@ -0,0 +1,135 @@
+package gowords_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromCSV(t *testing.T) {
+	want := map[string]string{
+		"title_EN":        "MyApp",
+		"title_FA":        "برنامه من",
+		"title_DE":        "MeineApp",
+		"greeting_EN":     "Hello, World",
+		"greeting_FA":     "سلام، دنیا",
+		"greeting_DE":     "Hallo Welt",
+		"multiline_EN":    "First\nSecond",
+		"quote_EN":        `Say "Hi"`,
+		"quote_DE":        "Sag „Hallo“",
+		"untranslated_EN": "Only English",
+	}
+	wCollection, err := NewWordsCollectionFromCSV(openWords(t, "messages.csv"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromCSV(openWords(t, "messages.csv"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	storages := map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}
+	findAll(t, storages, want)
+	for kind, words := range storages {
+		for _, name := range []string{"untranslated_FA", "key_EN", "title"} {
+			if value, found := words.Find(name); found {
+				t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, "", false)
+			}
+		}
+	}
+
+	var FA core.Suffix = "_FA"
+	wordsFA, err := NewWithSuffix(wCollection, FA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := wordsFA.Get("greeting"); got != "سلام، دنیا" {
+		t.Errorf("WithSuffix.Get() = %q, want %q", got, "سلام، دنیا")
+	}
+}
+
+func TestNewWordsFromCSV_TSV(t *testing.T) {
+	w, err := NewWordsCollectionFromCSV(openWords(t, "messages.tsv"), '\t')
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": w}, map[string]string{
+		"title_EN":    "MyApp",
+		"title_FA":    "برنامه من",
+		"greeting_EN": "Hello, World",
+	})
+}
+
+func TestNewWordsFromCSV_TSV_Quotes(t *testing.T) {
+	w, err := NewWordsCollectionFromCSV(strings.NewReader("key\tEN\tFA\ntitle\tHi \"x\"\tS\nquoted\t\"a\tb\"\tc\n"), '\t')
+	if err != nil {
+		t.Fatal(err)
+	}
+	findAll(t, map[string]Words{"WordsCollection": w}, map[string]string{
+		"title_EN":  "Hi \"x\"",
+		"title_FA":  "S",
+		"quoted_EN": "a\tb",
+		"quoted_FA": "c",
+	})
+}
+
+func TestNewWordsCollectionsFromCSV(t *testing.T) {
+	collections, err := NewWordsCollectionsFromCSV(openWords(t, "messages.csv"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(collections) != 3 {
+		t.Fatalf("NewWordsCollectionsFromCSV() = %v, want 3 languages", collections)
+	}
+	findAll(t, map[string]Words{"EN": collections["EN"]}, map[string]string{"title": "MyApp", "untranslated": "Only English"})
+	findAll(t, map[string]Words{"FA": collections["FA"]}, map[string]string{"title": "برنامه من", "greeting": "سلام، دنیا"})
+	findAll(t, map[string]Words{"DE": collections["DE"]}, map[string]string{"quote": "Sag „Hallo“"})
+	if value, found := collections["FA"].Find("untranslated"); found {
+		t.Errorf("WordsCollection.Find() = %q, %v, want %q, %v", value, found, "", false)
+	}
+}
+
+func TestNewWordsFromCSV_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		wantErr    error
+		wantLine   int
+		wantColumn int
+	}{
+		{"no language", "key\ntitle", core.ErrTableInvalid, 1, 1},
+		{"empty language", "key,EN,,FA\ntitle,a,b,c", core.ErrTableInvalid, 1, 8},
+		{"duplicated language", "key,EN,EN\ntitle,a,b", core.ErrTableInvalid, 1, 8},
+		{"name not present", "key,EN\ntitle,a\n ,b", core.ErrNameNotPresent, 3, 1},
+		{"duplicated name", "key,EN\ntitle,a\ntitle,b", core.ErrNameDuplicated, 3, 1},
+		{"duplicated suffixed name", "key,EN,FA_EN\nv_FA,a,\nv,,b", core.ErrNameDuplicated, 3, 4},
+		{"field count", "key,EN\ntitle,a,b", csv.ErrFieldCount, 2, 1},
+		{"quote", "key,EN\ntitle,\"a\"b", csv.ErrQuote, 2, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromCSV(strings.NewReader(tt.source), ',')
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewWordsCollectionFromCSV() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine || parseError.Column != tt.wantColumn {
+				t.Errorf("NewWordsCollectionFromCSV() error = %+v, want line %v and column %v", parseError, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+
+	if _, err := NewWordsCollectionFromCSV(nil, ','); !errors.Is(err, core.ErrReaderNil) {
+		t.Errorf("NewWordsCollectionFromCSV() error = %v, want %v", err, core.ErrReaderNil)
+	}
+	if _, err := NewWordsCollectionFromCSV(strings.NewReader(" "), ','); !errors.Is(err, core.ErrWordsEmpty) {
+		t.Errorf("NewWordsCollectionFromCSV() error = %v, want %v", err, core.ErrWordsEmpty)
+	}
+}
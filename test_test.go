@@ -2,8 +2,12 @@ package gowords_test
 
 import (
 	"os"
+	"path"
+	"testing"
 
 	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
 )
 
 const (
@@ -20,7 +24,25 @@ func init() {
 	var _ Words = WordsCollection{}
 	var _ Words = WordsFile{}
 	var _ Words = WordsRepository{}
+	var _ Words = WordsSorted{}
 	var _ Words = WithSuffix{}
+	var _ Words = WithPrefix{}
+	var _ FallibleWords = WordsFile{}
+	var _ FallibleWords = WithSuffix{}
+	var _ FallibleWords = WithPrefix{}
+	var _ FallibleWords = DoAnnotation{}
+	var _ Enumerable = WordsCollection{}
+	var _ Enumerable = WordsFile{}
+	var _ Enumerable = WordsRepository{}
+	var _ Enumerable = WordsSorted{}
+	var _ Enumerable = WithSuffix{}
+	var _ Enumerable = WithPrefix{}
+	var _ PrefixWords = WordsCollection{}
+	var _ PrefixWords = WordsFile{}
+	var _ PrefixWords = WordsRepository{}
+	var _ PrefixWords = WordsSorted{}
+	var _ PrefixWords = WithPrefix{}
+	var _ Enumerable = DoAnnotation{}
 }
 
 func init() {
@@ -30,3 +52,102 @@ func init() {
 		panic(err)
 	}
 }
+
+// wordsMap a Words which is not Enumerable
+type wordsMap map[string]string
+
+func (w wordsMap) Get(name string) string {
+	return w[name]
+}
+
+func (w wordsMap) Find(name string) (string, bool) {
+	value, found := w[name]
+	return value, found
+}
+
+// storagesWith create all storages of named file of words with options
+func storagesWith(t *testing.T, name string, options ...Option) map[string]Words {
+	t.Helper()
+	return storagesFrom(t, path.Join(path_WORDS, name), options...)
+}
+
+// storagesOf create all storages of source with options
+func storagesOf(t *testing.T, source string, options ...Option) map[string]Words {
+	t.Helper()
+	name := path.Join(t.TempDir(), "words")
+	if err := os.WriteFile(name, []byte(source), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return storagesFrom(t, name, options...)
+}
+
+// storagesFrom create all storages of file of words with options
+func storagesFrom(t *testing.T, name string, options ...Option) map[string]Words {
+	t.Helper()
+	source, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+	wRepository, err := NewWordsRepository(string(source), core.Separator, core.Comment, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wCollection, err := NewWordsCollection(string(source), core.Separator, core.Comment, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wSorted, err := NewWordsSorted(string(source), core.Separator, core.Comment, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wFile, err := NewWordsFile(file, core.Separator, core.Comment, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wFile.CheckError(); err != nil {
+		t.Fatal(err)
+	}
+	wIndexed, err := NewWordsFileIndexed(file, core.Separator, core.Comment, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]Words{
+		"WordsRepository":   wRepository,
+		"WordsCollection":   wCollection,
+		"WordsSorted":       wSorted,
+		"WordsFile":         wFile,
+		"WordsFile indexed": wIndexed,
+	}
+}
+
+// openWords open named file of words and close it on cleanup
+func openWords(t *testing.T, name string) *os.File {
+	t.Helper()
+	file, err := os.Open(path.Join(path_WORDS, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+	return file
+}
+
+// findAll check to find all names with wanted values in all storages
+func findAll(t *testing.T, storages map[string]Words, want map[string]string) {
+	t.Helper()
+	for kind, words := range storages {
+		for name, wantValue := range want {
+			gotValue, gotFound, err := FindErr(words, name)
+			if err != nil {
+				t.Errorf("%s.FindErr(%q) error = %v", kind, name, err)
+			}
+			if gotValue != wantValue || !gotFound {
+				t.Errorf("%s.FindErr(%q) = %q, %v, want %q, %v", kind, name, gotValue, gotFound, wantValue, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package gowords
+
+import (
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Validate check whole source and return all invalid lines and duplicated names as "core.ParseErrors",
+// unlike instantiation functions which stop at the first error.
+// Return nil if the source is valid.
+func Validate(source string, separator rune, comment rune, options ...Option) error {
+	return validate(source, internal.Empty, separator, comment, options)
+}
+
+// ValidateReader check whole source read from reader and return all invalid lines and duplicated names as "core.ParseErrors".
+// Return nil if the source is valid.
+func ValidateReader(reader io.Reader, separator rune, comment rune, options ...Option) error {
+	if reader == nil {
+		return core.ErrReaderNil
+	}
+
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	return validate(string(source), internal.SourceName(reader), separator, comment, options)
+}
+
+// ValidateFS check whole source of named file of file system and return all invalid lines and duplicated names as "core.ParseErrors".
+// Return nil if the source is valid.
+func ValidateFS(fsys fs.FS, name string, separator rune, comment rune, options ...Option) error {
+	if fsys == nil {
+		return core.ErrFileSystemNil
+	}
+
+	source, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	return validate(string(source), name, separator, comment, options)
+}
+
+// validate check whole source and return all errors, the name of source is used in errors
+func validate(source string, name string, separator rune, comment rune, options []Option) error {
+	source = internal.DecodeUnicodeText(source)
+
+	err := internal.ValidationSource(source)
+	if err != nil {
+		return err
+	}
+
+	config, err := configure(separator, comment, options)
+	if err != nil {
+		return err
+	}
+
+	faults, err := internal.Check(internal.NewScanner(strings.NewReader(source), name, config.grammar))
+	if err != nil {
+		return err
+	}
+	if len(faults) > 0 {
+		return core.ParseErrors(faults)
+	}
+	return nil
+}
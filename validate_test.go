@@ -0,0 +1,134 @@
+package gowords_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+// multiple_WANT the errors of "invalid_multiple" source, as pairs of line number and previous line number
+var multiple_WANT = []struct {
+	line     int
+	previous int
+	err      error
+}{
+	{2, 0, core.ErrNameNotPresent},
+	{4, 0, core.ErrSeparatorNotPresent},
+	{5, 1, core.ErrNameDuplicated},
+	{6, 3, core.ErrNameDuplicated},
+	{7, 0, core.ErrNameNotPresent},
+	{8, 1, core.ErrNameDuplicated},
+}
+
+// checkMultiple check the errors of "invalid_multiple" source
+func checkMultiple(t *testing.T, err error, source string) {
+	t.Helper()
+	var faults core.ParseErrors
+	if !errors.As(err, &faults) {
+		t.Fatalf("error = %v, want core.ParseErrors", err)
+	}
+	if len(faults) != len(multiple_WANT) {
+		t.Fatalf("got %d errors, want %d: %v", len(faults), len(multiple_WANT), err)
+	}
+	for index, want := range multiple_WANT {
+		got := faults[index]
+		if got.Line != want.line || got.Previous != want.previous || !errors.Is(got, want.err) || got.Source != source {
+			t.Errorf("error[%d] = %+v, want line %d previous %d %v", index, got, want.line, want.previous, want.err)
+		}
+	}
+}
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestValidate(t *testing.T) {
+	valid_source, err := os.ReadFile(path.Join(path_WORDS, "valid_sparse__source"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid_multiple, err := os.ReadFile(path.Join(path_WORDS, "invalid_multiple"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(string(valid_source), core.Separator, core.Comment); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	checkMultiple(t, Validate(string(invalid_multiple), core.Separator, core.Comment), internal.Empty)
+}
+
+func TestValidate_Instantiation(t *testing.T) {
+	type args struct {
+		source    string
+		separator rune
+		comment   rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check invalid source", args{source: internal.Empty, separator: core.Separator, comment: core.Comment}, core.ErrWordsEmpty},
+		{"check invalid separator delimiters", args{source: "k=v", separator: 'x', comment: core.Comment}, core.ErrSeparatorIsInvalid},
+		{"check invalid comment delimiters", args{source: "k=v", separator: core.Separator, comment: 'x'}, core.ErrCommentIsInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Validate(tt.args.source, tt.args.separator, tt.args.comment); !errors.Is(got, tt.want) {
+				t.Errorf("Validate() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateReader(t *testing.T) {
+	file, err := os.Open(path.Join(path_WORDS, "invalid_multiple"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	checkMultiple(t, ValidateReader(file, core.Separator, core.Comment), file.Name())
+	tests := []struct {
+		name   string
+		reader io.Reader
+		want   error
+	}{
+		{"check nil reader", nil, core.ErrReaderNil},
+		{"check invalid source", strings.NewReader(internal.Empty), core.ErrWordsEmpty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateReader(tt.reader, core.Separator, core.Comment); !errors.Is(got, tt.want) {
+				t.Errorf("ValidateReader() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFS(t *testing.T) {
+	checkMultiple(t, ValidateFS(os.DirFS(path_WORDS), "invalid_multiple", core.Separator, core.Comment), "invalid_multiple")
+	tests := []struct {
+		name string
+		fsys fs.FS
+		want error
+	}{
+		{"check nil file system", nil, core.ErrFileSystemNil},
+		{"check not exist file", fstest.MapFS{}, fs.ErrNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateFS(tt.fsys, "invalid_multiple", core.Separator, core.Comment); !errors.Is(got, tt.want) {
+				t.Errorf("ValidateFS() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
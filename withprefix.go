@@ -0,0 +1,134 @@
+package gowords
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WithPrefix utilize Words interface with prefix to provide words table and text resource scoped to a namespace such as "billing."
+type WithPrefix struct { //EXTENDS: Words
+	Words
+	prefix string
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// Get search for a name with prefix then return value if found, else return empty string
+func (w WithPrefix) Get(name string) string {
+	value, _ := w.Find(name)
+	return value
+}
+
+// Find search for a name with prefix then return value and `true` if found, else return empty string and `false`
+func (w WithPrefix) Find(name string) (string, bool) {
+	name, ok := internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, false
+	}
+	return w.Words.Find(w.prefix + name)
+}
+
+// FindErr search for a name with prefix then return value and `true` if found, else return empty string and `false`.
+// Also return the error occurred in this call if underlying Words implements FallibleWords.
+func (w WithPrefix) FindErr(name string) (string, bool, error) {
+	name, ok := internal.ValidationName(name)
+	if !ok {
+		return internal.Empty, false, nil
+	}
+	return FindErr(w.Words, w.prefix+name)
+}
+
+// Len return number of names with prefix, return 0 if underlying Words is not Enumerable
+func (w WithPrefix) Len() int {
+	var count int
+	w.Range(func(string, string) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Keys return names with prefix in order of source without the prefix, return nil if underlying Words is not Enumerable
+func (w WithPrefix) Keys() []string {
+	var keys []string
+	w.Range(func(name string, _ string) bool {
+		keys = append(keys, name)
+		return true
+	})
+	return keys
+}
+
+// Range call yield for each name with prefix and its value in order of source, the name is passed without the prefix.
+// Stop when yield return `false`, and do nothing if underlying Words is not Enumerable.
+func (w WithPrefix) Range(yield func(name string, value string) bool) {
+	if source, ok := w.Words.(Enumerable); ok {
+		source.Range(w.strip(yield))
+	}
+}
+
+// FindPrefix return all names with prefix of WithPrefix which start with prefix and their values, without prefix of WithPrefix
+func (w WithPrefix) FindPrefix(prefix string) map[string]string {
+	return collectPrefix(func(yield func(string, string) bool) { w.ScanPrefix(prefix, yield) })
+}
+
+// ScanPrefix call yield for each name with prefix of WithPrefix which start with prefix and its value in sorted order of names,
+// the name is passed without prefix of WithPrefix. Stop when yield return `false`.
+// The index of underlying Words is used if it is PrefixWords, and nothing is done if underlying Words is not Enumerable.
+func (w WithPrefix) ScanPrefix(prefix string, yield func(name string, value string) bool) {
+	_ = ScanPrefix(w.Words, w.prefix+prefix, w.strip(yield))
+}
+
+// normalization return the function to normalize names of underlying Words, nil if names are kept as is
+func (w WithPrefix) normalization() func(string) string {
+	return normalizationOf(w.Words)
+}
+
+// walk call yield for each name with prefix and its value in order of source, the name is passed without the prefix
+func (w WithPrefix) walk(yield func(name string, value string) bool) error {
+	return enumerate(w.Words, w.strip(yield))
+}
+
+// strip return a function to call yield only for names with prefix, passing the name without the prefix.
+// If underlying Words normalize names, the shortest part of name which is normalized with the prefix to the name is passed,
+// since normalization of the prefix alone may differ from its part of name, such as trimmed spaces.
+func (w WithPrefix) strip(yield func(name string, value string) bool) func(string, string) bool {
+	var normalize = normalizationOf(w.Words)
+	return func(name string, value string) bool {
+		if normalize == nil {
+			if len(name) <= len(w.prefix) || !strings.HasPrefix(name, w.prefix) {
+				return true
+			}
+			return yield(name[len(w.prefix):], value)
+		}
+		for index := len(name) - 1; index > 0; index-- {
+			if utf8.RuneStart(name[index]) && normalize(w.prefix+name[index:]) == name {
+				return yield(name[index:], value)
+			}
+		}
+		return true
+	}
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWithPrefix create a new instance of WithPrefix
+func NewWithPrefix(words Words, prefix core.Prefix) (Words, error) {
+	if words == nil {
+		return nil, core.ErrWordsNil
+	}
+
+	strprefix, ok := internal.ValidationPrefix(string(prefix))
+	if !ok {
+		return nil, core.ErrPrefixIsInvalid
+	}
+
+	return WithPrefix{
+		Words:  words,
+		prefix: strprefix,
+	}, nil
+}
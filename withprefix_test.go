@@ -0,0 +1,234 @@
+package gowords_test
+
+import (
+	"errors"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWithPrefix_Instantiation(t *testing.T) {
+	words, err := NewWordsCollection("billing.title = Billing", core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		words  Words
+		prefix core.Prefix
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"check invalid words", args{words: nil, prefix: "billing."}, core.ErrWordsNil},
+		{"check empty prefix", args{words: words, prefix: core.Prefix(internal.Empty)}, core.ErrPrefixIsInvalid},
+		{"check invalid prefix", args{words: words, prefix: "  "}, core.ErrPrefixIsInvalid},
+		{"check new line prefix", args{words: words, prefix: "billing.\n"}, core.ErrPrefixIsInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := NewWithPrefix(tt.args.words, tt.args.prefix); !errors.Is(got, tt.want) {
+				t.Errorf("NewWithPrefix() error = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithPrefix_Find(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    core.Prefix
+		key       string
+		want      string
+		wantFound bool
+	}{
+		{"found", "billing.", "title", "Billing", true},
+		{"found space", "  billing.", "  title ", "Billing", true},
+		{"other prefix", "shipping.", "title", "Shipping", true},
+		{"not found", "billing.", key_NOTFOUND, internal.Empty, false},
+		{"not scoped", "billing.", "billing.title", internal.Empty, false},
+		{"empty", "billing.", internal.Empty, internal.Empty, false},
+	}
+	for kind, words := range storagesWith(t, "withprefix") {
+		for _, tt := range tests {
+			t.Run(kind+" "+tt.name, func(t *testing.T) {
+				w, err := NewWithPrefix(words, tt.prefix)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, found := w.Find(tt.key)
+				if got != tt.want || found != tt.wantFound {
+					t.Errorf("WithPrefix.Find() = %v, %v, want %v, %v", got, found, tt.want, tt.wantFound)
+				}
+				if got := w.Get(tt.key); got != tt.want {
+					t.Errorf("WithPrefix.Get() = %v, want %v", got, tt.want)
+				}
+				got, found, err = w.(WithPrefix).FindErr(tt.key)
+				if got != tt.want || found != tt.wantFound || err != nil {
+					t.Errorf("WithPrefix.FindErr() = %v, %v, %v, want %v, %v, nil", got, found, err, tt.want, tt.wantFound)
+				}
+			})
+		}
+	}
+}
+
+func TestWithPrefix_Range(t *testing.T) {
+	wantKeys := []string{"title", "invoice_EN", "invoice_FA", "total"}
+	for kind, words := range storagesWith(t, "withprefix") {
+		w, err := NewWithPrefix(words, "billing.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		enumerable := w.(Enumerable)
+		if got := enumerable.Len(); got != len(wantKeys) {
+			t.Errorf("%s WithPrefix.Len() = %v, want %v", kind, got, len(wantKeys))
+		}
+		if got := enumerable.Keys(); !reflect.DeepEqual(got, wantKeys) {
+			t.Errorf("%s WithPrefix.Keys() = %q, want %q", kind, got, wantKeys)
+		}
+		var got []string
+		w.(PrefixWords).ScanPrefix("in", func(name string, value string) bool {
+			got = append(got, name+"="+value)
+			return true
+		})
+		if want := []string{"invoice_EN=Invoice", "invoice_FA=صورتحساب"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix.ScanPrefix() = %q, want %q", kind, got, want)
+		}
+		if got, want := w.(PrefixWords).FindPrefix(""), map[string]string{"title": "Billing", "invoice_EN": "Invoice", "invoice_FA": "صورتحساب", "total": "Total: {{amount}}"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix.FindPrefix() = %v, want %v", kind, got, want)
+		}
+	}
+}
+
+func TestWithPrefix_Compose(t *testing.T) {
+	for kind, words := range storagesWith(t, "withprefix") {
+		wPrefix, err := NewWithPrefix(words, "billing.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wSuffix, err := NewWithSuffix(wPrefix, "_FA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := wSuffix.Get("invoice"); got != "صورتحساب" {
+			t.Errorf("%s WithSuffix(WithPrefix).Get() = %q, want %q", kind, got, "صورتحساب")
+		}
+		if got, want := wSuffix.(Enumerable).Keys(), []string{"invoice"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix(WithPrefix).Keys() = %q, want %q", kind, got, want)
+		}
+
+		wSuffix, err = NewWithSuffix(words, "_EN")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wPrefix, err = NewWithPrefix(wSuffix, "billing.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := wPrefix.Get("invoice"); got != "Invoice" {
+			t.Errorf("%s WithPrefix(WithSuffix).Get() = %q, want %q", kind, got, "Invoice")
+		}
+		if got, want := wPrefix.(Enumerable).Keys(), []string{"invoice"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix(WithSuffix).Keys() = %q, want %q", kind, got, want)
+		}
+
+		wPrefix, err = NewWithPrefix(words, "billing.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wAnnotation, err := NewDoAnnotation(wPrefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := wAnnotation.GetNamed("total", map[string]any{"amount": 10}); got != "Total: 10" {
+			t.Errorf("%s DoAnnotation(WithPrefix).GetNamed() = %q, want %q", kind, got, "Total: 10")
+		}
+		if got := wAnnotation.Len(); got != 4 {
+			t.Errorf("%s DoAnnotation(WithPrefix).Len() = %v, want %v", kind, got, 4)
+		}
+	}
+}
+
+func TestWithPrefix_FindErr(t *testing.T) {
+	sourceFile, err := os.Open(path.Join(path_WORDS, "withprefix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sourceFile.Close()
+	wFile, err := NewWordsFile(sourceFile, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWithPrefix(wFile, "billing.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceFile.Close()
+	if _, _, err := w.(WithPrefix).FindErr("title"); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("WithPrefix.FindErr() error = %v, want %v", err, os.ErrClosed)
+	}
+}
+
+func TestWithPrefix_Folding_Space(t *testing.T) {
+	const source string = "App name = Name\nApp  Title = Title\nApplication = Application\n"
+	for kind, words := range storagesOf(t, source, WithFolding(core.FoldCase|core.FoldSpace)) {
+		w, err := NewWithPrefix(words, "App ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.(Enumerable).Keys(), []string{"name", "title"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix.Keys() = %q, want %q", kind, got, want)
+		}
+		if got := w.Get("Title"); got != "Title" {
+			t.Errorf("%s WithPrefix.Get() = %q, want %q", kind, got, "Title")
+		}
+	}
+}
+
+func TestWithPrefix_Folding(t *testing.T) {
+	const source string = "Billing.Title = Billing\nbilling.Invoice_EN = Invoice\nTitle_EN = MyApp\n"
+	for kind, words := range storagesOf(t, source, WithFolding(core.FoldCase)) {
+		wPrefix, err := NewWithPrefix(words, "BILLING.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := wPrefix.(Enumerable).Keys(), []string{"title", "invoice_en"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix.Keys() = %q, want %q", kind, got, want)
+		}
+		if got := wPrefix.(Enumerable).Len(); got != 2 {
+			t.Errorf("%s WithPrefix.Len() = %v, want %v", kind, got, 2)
+		}
+		if got, want := wPrefix.(PrefixWords).FindPrefix("INV"), map[string]string{"invoice_en": "Invoice"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix.FindPrefix() = %v, want %v", kind, got, want)
+		}
+
+		wSuffix, err := NewWithSuffix(words, "_en")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := wSuffix.(Enumerable).Keys(), []string{"billing.invoice", "title"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix.Keys() = %q, want %q", kind, got, want)
+		}
+
+		wComposed, err := NewWithPrefix(wSuffix, "Billing.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := wComposed.(Enumerable).Keys(), []string{"invoice"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithPrefix(WithSuffix).Keys() = %q, want %q", kind, got, want)
+		}
+		if got := wComposed.Get("INVOICE"); got != "Invoice" {
+			t.Errorf("%s WithPrefix(WithSuffix).Get() = %q, want %q", kind, got, "Invoice")
+		}
+	}
+}
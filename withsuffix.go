@@ -1,6 +1,9 @@
 package gowords
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/saleh-rahimzadeh/go-words/core"
 	"github.com/saleh-rahimzadeh/go-words/internal"
 )
@@ -25,6 +28,71 @@ func (w WithSuffix) Find(name string) (string, bool) {
 	return w.Words.Find(name + w.suffix)
 }
 
+// FindErr search for a name with suffix then return value and `true` if found, else return empty string and `false`.
+// Also return the error occurred in this call if underlying Words implements FallibleWords.
+func (w WithSuffix) FindErr(name string) (string, bool, error) {
+	return FindErr(w.Words, name+w.suffix)
+}
+
+// Len return number of names with suffix, return 0 if underlying Words is not Enumerable
+func (w WithSuffix) Len() int {
+	var count int
+	w.Range(func(string, string) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Keys return names with suffix in order of source without the suffix, return nil if underlying Words is not Enumerable
+func (w WithSuffix) Keys() []string {
+	var keys []string
+	w.Range(func(name string, _ string) bool {
+		keys = append(keys, name)
+		return true
+	})
+	return keys
+}
+
+// Range call yield for each name with suffix and its value in order of source, the name is passed without the suffix.
+// Stop when yield return `false`, and do nothing if underlying Words is not Enumerable.
+func (w WithSuffix) Range(yield func(name string, value string) bool) {
+	if source, ok := w.Words.(Enumerable); ok {
+		source.Range(w.strip(yield))
+	}
+}
+
+// normalization return the function to normalize names of underlying Words, nil if names are kept as is
+func (w WithSuffix) normalization() func(string) string {
+	return normalizationOf(w.Words)
+}
+
+// walk call yield for each name with suffix and its value in order of source, the name is passed without the suffix
+func (w WithSuffix) walk(yield func(name string, value string) bool) error {
+	return enumerate(w.Words, w.strip(yield))
+}
+
+// strip return a function to call yield only for names with suffix, passing the name without the suffix.
+// If underlying Words normalize names, the shortest part of name which is normalized with the suffix to the name is passed,
+// since normalization of the suffix alone may differ from its part of name, such as trimmed spaces.
+func (w WithSuffix) strip(yield func(name string, value string) bool) func(string, string) bool {
+	var normalize = normalizationOf(w.Words)
+	return func(name string, value string) bool {
+		if normalize == nil {
+			if len(name) <= len(w.suffix) || !strings.HasSuffix(name, w.suffix) {
+				return true
+			}
+			return yield(name[:len(name)-len(w.suffix)], value)
+		}
+		for index := 1; index < len(name); index++ {
+			if utf8.RuneStart(name[index]) && normalize(name[:index]+w.suffix) == name {
+				return yield(name[:index], value)
+			}
+		}
+		return true
+	}
+}
+
 //──────────────────────────────────────────────────────────────────────────────────────────────────
 
 // NewWordsRepository create a new instance of WithSuffix
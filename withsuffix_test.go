@@ -1,8 +1,12 @@
 package gowords_test
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	. "github.com/saleh-rahimzadeh/go-words"
@@ -201,3 +205,122 @@ func TestWithSuffix_Find(t *testing.T) {
 		})
 	}
 }
+
+func TestWithSuffix_FindErr(t *testing.T) {
+	sourceFile, err := os.Open(path.Join(path_WORDS, "withsuffix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sourceFile.Close()
+	wFile, err := NewWordsFile(sourceFile, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wEN, err := NewWithSuffix(wFile, "_EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, found, err := wEN.(WithSuffix).FindErr("k1")
+	if err != nil {
+		t.Errorf("WithSuffix.FindErr() error = %v", err)
+	}
+	if got != "v1 EN" || !found {
+		t.Errorf("WithSuffix.FindErr() = %v, %v, want %v, %v", got, found, "v1 EN", true)
+	}
+	sourceFile.Close()
+	if _, _, err := wEN.(WithSuffix).FindErr("k1"); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("WithSuffix.FindErr() error = %v, want %v", err, os.ErrClosed)
+	}
+}
+
+func TestWithSuffix_Range(t *testing.T) {
+	const source string = "title_EN = Title\ntitle_FA = عنوان\nbody_EN = Body\n_EN = only suffix"
+	for kind, words := range storagesOf(t, source) {
+		wEN, err := NewWithSuffix(words, "_EN")
+		if err != nil {
+			t.Fatal(err)
+		}
+		enumerable := wEN.(Enumerable)
+		if got := enumerable.Len(); got != 2 {
+			t.Errorf("%s WithSuffix.Len() = %v, want %v", kind, got, 2)
+		}
+		if got, want := enumerable.Keys(), []string{"title", "body"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix.Keys() = %q, want %q", kind, got, want)
+		}
+		got := map[string]string{}
+		enumerable.Range(func(name string, value string) bool {
+			got[name] = value
+			return true
+		})
+		if want := map[string]string{"title": "Title", "body": "Body"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix.Range() = %v, want %v", kind, got, want)
+		}
+		var buffer bytes.Buffer
+		if err := WriteJSON(&buffer, wEN); err != nil {
+			t.Errorf("%s WriteJSON() error = %v", kind, err)
+		}
+		if want := "{\n  \"title\": \"Title\",\n  \"body\": \"Body\"\n}\n"; buffer.String() != want {
+			t.Errorf("%s WriteJSON() = %q, want %q", kind, buffer.String(), want)
+		}
+	}
+}
+
+func TestWithSuffix_RangeErr(t *testing.T) {
+	sourceFile, err := os.Open(path.Join(path_WORDS, "withsuffix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wFile, err := NewWordsFile(sourceFile, core.Separator, core.Comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wEN, err := NewWithSuffix(wFile, "_EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceFile.Close()
+	if got := wEN.(Enumerable).Keys(); got != nil {
+		t.Errorf("WithSuffix.Keys() = %q, want nil", got)
+	}
+	if err := wFile.Err(); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("WordsFile.Err() = %v, want %v", err, os.ErrClosed)
+	}
+	if err := WriteJSON(io.Discard, wEN); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("WriteJSON() error = %v, want %v", err, os.ErrClosed)
+	}
+}
+
+func TestWithSuffix_Folding(t *testing.T) {
+	const source string = "Title_EN = MyApp\nMenu_En = Menu\nTitle_FA = برنامه\n"
+	for kind, words := range storagesOf(t, source, WithFolding(core.FoldCase)) {
+		w, err := NewWithSuffix(words, "_EN")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.(Enumerable).Keys(), []string{"title", "menu"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix.Keys() = %q, want %q", kind, got, want)
+		}
+		if got := w.(Enumerable).Len(); got != 2 {
+			t.Errorf("%s WithSuffix.Len() = %v, want %v", kind, got, 2)
+		}
+		if got := w.Get("MENU"); got != "Menu" {
+			t.Errorf("%s WithSuffix.Get() = %q, want %q", kind, got, "Menu")
+		}
+	}
+}
+
+func TestWithSuffix_Folding_Space(t *testing.T) {
+	const source string = "Title EN = MyApp\nMenu  en = Menu\nTitle = Title\n"
+	for kind, words := range storagesOf(t, source, WithFolding(core.FoldCase|core.FoldSpace)) {
+		w, err := NewWithSuffix(words, " EN")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.(Enumerable).Keys(), []string{"title", "menu"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s WithSuffix.Keys() = %q, want %q", kind, got, want)
+		}
+		if got := w.Get("Menu"); got != "Menu" {
+			t.Errorf("%s WithSuffix.Get() = %q, want %q", kind, got, "Menu")
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package gowords
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/saleh-rahimzadeh/go-words/internal"
+)
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// NewWordsCollectionFromXLIFF create a new instance of WordsCollection by reading XLIFF 1.2 or 2.0 documents,
+// names of each resource are joined by its suffix.
+// The name is `resname` of `<trans-unit>` or `name` of `<unit>` if present, else `id`.
+// If the document has a target language, the value is the target text and untranslated units are skipped,
+// else the value is the source text.
+func NewWordsCollectionFromXLIFF(resources ...Resource) (WordsCollection, error) {
+	return collectionFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseXLIFF) })
+}
+
+// NewWordsRepositoryFromXLIFF create a new instance of WordsRepository by reading XLIFF 1.2 or 2.0 documents,
+// same as "NewWordsCollectionFromXLIFF"
+func NewWordsRepositoryFromXLIFF(resources ...Resource) (WordsRepository, error) {
+	return repositoryFrom(func() ([]internal.Record, error) { return readResources(resources, internal.ParseXLIFF) })
+}
+
+//──────────────────────────────────────────────────────────────────────────────────────────────────
+
+// WriteXLIFF write names and values of words as source texts of a XLIFF 2.0 document in order of source, for translation.
+// The language is the source language like "en". Names are ids of units, or `name` of units if they are not valid ids,
+// then ids are generated like "u2" by position of units and skip ids of other names.
+// Words must be Enumerable.
+func WriteXLIFF(writer io.Writer, words Words, language string) error {
+	var (
+		pairs [][2]string
+		ids   map[string]bool = make(map[string]bool) // Ids of units which are taken by names
+	)
+	err := enumerate(words, func(name string, value string) bool {
+		pairs = append(pairs, [2]string{name, value})
+		if internal.ValidToken(name) {
+			ids[name] = true
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	var buffer = bufio.NewWriter(writer)
+	buffer.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	buffer.WriteString("<xliff xmlns=\"urn:oasis:names:tc:xliff:document:2.0\" version=\"2.0\" srcLang=" + internal.QuoteXML(language) + ">\n")
+	buffer.WriteString("  <file id=\"f1\">\n")
+	for index, pair := range pairs {
+		var name, value = pair[0], pair[1]
+		var attributes = " id=" + internal.QuoteXML(name)
+		if !internal.ValidToken(name) {
+			// Generated id is the position of unit, or the next one which is not taken
+			var id string
+			for number := index + 1; id == internal.Empty || ids[id]; number++ {
+				id = "u" + strconv.Itoa(number)
+			}
+			ids[id] = true
+			attributes = " id=" + internal.QuoteXML(id) + " name=" + internal.QuoteXML(name)
+		}
+		buffer.WriteString("    <unit" + attributes + ">\n")
+		buffer.WriteString("      <segment>\n")
+		buffer.WriteString("        <source>" + internal.EscapeXML(value) + "</source>\n")
+		buffer.WriteString("      </segment>\n")
+		buffer.WriteString("    </unit>\n")
+	}
+	buffer.WriteString("  </file>\n</xliff>\n")
+
+	return buffer.Flush()
+}
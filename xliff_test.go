@@ -0,0 +1,130 @@
+package gowords_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/saleh-rahimzadeh/go-words"
+
+	"github.com/saleh-rahimzadeh/go-words/core"
+)
+
+//┌ Test
+//└─────────────────────────────────────────────────────────────────────────────────────────────────
+
+func TestNewWordsFromXLIFF(t *testing.T) {
+	const EN core.Suffix = "_EN"
+	const FA core.Suffix = "_FA"
+	want := map[string]string{
+		"app_name_FA":        "برنامه من",
+		"errors.notfound_FA": "پیدا نشد",
+		"greeting_FA":        `سلام <g id="1">کاربر</g> عزیز`,
+		"app_name_EN":        "MyApp",
+		"long text_EN":       "First sentence. Second & last.",
+	}
+	resources := func() []Resource {
+		return []Resource{
+			{Reader: openWords(t, "messages.xlf"), Suffix: FA},
+			{Reader: openWords(t, "messages20.xlf"), Suffix: EN},
+		}
+	}
+	wCollection, err := NewWordsCollectionFromXLIFF(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wRepository, err := NewWordsRepositoryFromXLIFF(resources()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storages := map[string]Words{"WordsCollection": wCollection, "WordsRepository": wRepository}
+	findAll(t, storages, want)
+	for kind, words := range storages {
+		for _, name := range []string{"untranslated_FA", "1_FA", "errors_FA"} {
+			if value, found := words.Find(name); found {
+				t.Errorf("%s.Find(%q) = %q, %v, want %q, %v", kind, name, value, found, "", false)
+			}
+		}
+	}
+}
+
+func TestNewWordsFromXLIFF_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantErr  error
+		wantLine int
+	}{
+		{"root", "<resources>\n</resources>", core.ErrXMLInvalid, 1},
+		{"name not present", "<xliff>\n<file>\n<trans-unit>\n<source>v</source>\n</trans-unit>\n</file>\n</xliff>", core.ErrNameNotPresent, 3},
+		{"duplicated name", "<xliff>\n<file>\n<unit id=\"k\"><segment><source>v1</source></segment></unit>\n<unit id=\"k\"><segment><source>v2</source></segment></unit>\n</file>\n</xliff>", core.ErrNameDuplicated, 4},
+		{"syntax", "<xliff>\n<file>\n<unit id=\"k\">\n</file>\n</xliff>", nil, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWordsCollectionFromXLIFF(Resource{Reader: strings.NewReader(tt.source)})
+			var parseError *core.ParseError
+			if !errors.As(err, &parseError) || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("NewWordsCollectionFromXLIFF() error = %v, want %v", err, tt.wantErr)
+			}
+			if parseError.Line != tt.wantLine {
+				t.Errorf("NewWordsCollectionFromXLIFF() error = %+v, want line %v", parseError, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestWriteXLIFF(t *testing.T) {
+	const source string = "title = MyApp\nlong text = Tom & Jerry <3"
+	const want string = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en">
+  <file id="f1">
+    <unit id="title">
+      <segment>
+        <source>MyApp</source>
+      </segment>
+    </unit>
+    <unit id="u2" name="long text">
+      <segment>
+        <source>Tom &amp; Jerry &lt;3</source>
+      </segment>
+    </unit>
+  </file>
+</xliff>
+`
+	for kind, words := range storagesOf(t, source) {
+		var buffer bytes.Buffer
+		if err := WriteXLIFF(&buffer, words, "en"); err != nil {
+			t.Fatalf("%s WriteXLIFF() error = %v", kind, err)
+		}
+		if buffer.String() != want {
+			t.Errorf("%s WriteXLIFF() = %v, want %v", kind, buffer.String(), want)
+		}
+		w, err := NewWordsCollectionFromXLIFF(Resource{Reader: &buffer})
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromXLIFF() error = %v", kind, err)
+		}
+		findAll(t, map[string]Words{kind: w}, map[string]string{"title": "MyApp", "long text": "Tom & Jerry <3"})
+	}
+}
+
+func TestWriteXLIFF_Ids(t *testing.T) {
+	const source string = "first name = A\nu1 = B\nlast name = C\nu3 = D\n"
+	for kind, words := range storagesOf(t, source) {
+		var buffer bytes.Buffer
+		if err := WriteXLIFF(&buffer, words, "en"); err != nil {
+			t.Fatalf("%s WriteXLIFF() error = %v", kind, err)
+		}
+		for _, want := range []string{`<unit id="u2" name="first name">`, `<unit id="u1">`, `<unit id="u4" name="last name">`, `<unit id="u3">`} {
+			if !strings.Contains(buffer.String(), want) {
+				t.Errorf("%s WriteXLIFF() = %v, want unit %v", kind, buffer.String(), want)
+			}
+		}
+		w, err := NewWordsCollectionFromXLIFF(Resource{Reader: &buffer})
+		if err != nil {
+			t.Fatalf("%s NewWordsCollectionFromXLIFF() error = %v", kind, err)
+		}
+		findAll(t, map[string]Words{kind: w}, map[string]string{"first name": "A", "u1": "B", "last name": "C", "u3": "D"})
+	}
+}